@@ -229,6 +229,244 @@ func (api *SafeApiKit) EstimateTransaction(ctx context.Context, safeAddress stri
 	return &response, nil
 }
 
+// GetServiceInfo retrieves information about the Safe Transaction Service
+func (api *SafeApiKit) GetServiceInfo(ctx context.Context) (*SafeServiceInfoResponse, error) {
+	var response SafeServiceInfoResponse
+	err := api.makeRequest(ctx, "GET", "/api/v1/about/", nil, &response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get service info: %w", err)
+	}
+
+	return &response, nil
+}
+
+// GetSafeCreationInfo retrieves the creation details of a Safe
+func (api *SafeApiKit) GetSafeCreationInfo(ctx context.Context, safeAddress string) (*SafeCreationInfoResponse, error) {
+	endpoint := fmt.Sprintf("/api/v1/safes/%s/creation/", safeAddress)
+
+	var response SafeCreationInfoResponse
+	err := api.makeRequest(ctx, "GET", endpoint, nil, &response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Safe creation info: %w", err)
+	}
+
+	return &response, nil
+}
+
+// GetSafesByModule retrieves the Safes that have a module enabled
+func (api *SafeApiKit) GetSafesByModule(ctx context.Context, moduleAddress string) (*SafesByModuleResponse, error) {
+	endpoint := fmt.Sprintf("/api/v1/modules/%s/safes/", moduleAddress)
+
+	var response SafesByModuleResponse
+	err := api.makeRequest(ctx, "GET", endpoint, nil, &response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Safes by module: %w", err)
+	}
+
+	return &response, nil
+}
+
+// GetTokenList retrieves the tokens known to the service
+func (api *SafeApiKit) GetTokenList(ctx context.Context, options *TokenInfoListOptions) (*TokenInfoListResponse, error) {
+	endpoint := "/api/v1/tokens/"
+
+	if options != nil {
+		params := url.Values{}
+		if options.Limit != nil {
+			params.Add("limit", strconv.Itoa(*options.Limit))
+		}
+		if options.Offset != nil {
+			params.Add("offset", strconv.Itoa(*options.Offset))
+		}
+		if len(params) > 0 {
+			endpoint += "?" + params.Encode()
+		}
+	}
+
+	var response TokenInfoListResponse
+	err := api.makeRequest(ctx, "GET", endpoint, nil, &response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get token list: %w", err)
+	}
+
+	return &response, nil
+}
+
+// GetToken retrieves information about a token
+func (api *SafeApiKit) GetToken(ctx context.Context, tokenAddress string) (*TokenInfoResponse, error) {
+	endpoint := fmt.Sprintf("/api/v1/tokens/%s/", tokenAddress)
+
+	var response TokenInfoResponse
+	err := api.makeRequest(ctx, "GET", endpoint, nil, &response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get token: %w", err)
+	}
+
+	return &response, nil
+}
+
+// GetAllTransactions retrieves the multisig, module and incoming transactions of a Safe
+func (api *SafeApiKit) GetAllTransactions(ctx context.Context, safeAddress string, options *AllTransactionsOptions) (*AllTransactionsListResponse, error) {
+	endpoint := fmt.Sprintf("/api/v1/safes/%s/all-transactions/", safeAddress)
+
+	if options != nil {
+		params := url.Values{}
+		if options.Executed != nil {
+			params.Add("executed", strconv.FormatBool(*options.Executed))
+		}
+		if options.Queued != nil {
+			params.Add("queued", strconv.FormatBool(*options.Queued))
+		}
+		if options.Trusted != nil {
+			params.Add("trusted", strconv.FormatBool(*options.Trusted))
+		}
+		if options.Limit != nil {
+			params.Add("limit", strconv.Itoa(*options.Limit))
+		}
+		if options.Offset != nil {
+			params.Add("offset", strconv.Itoa(*options.Offset))
+		}
+		if len(params) > 0 {
+			endpoint += "?" + params.Encode()
+		}
+	}
+
+	var response AllTransactionsListResponse
+	err := api.makeRequest(ctx, "GET", endpoint, nil, &response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get all transactions: %w", err)
+	}
+
+	return &response, nil
+}
+
+// GetModuleTransactions retrieves the transactions executed by modules of a Safe
+func (api *SafeApiKit) GetModuleTransactions(ctx context.Context, safeAddress string, options *GetModuleTransactionsOptions) (*SafeModuleTransactionListResponse, error) {
+	endpoint := fmt.Sprintf("/api/v1/safes/%s/module-transactions/", safeAddress)
+
+	if options != nil {
+		params := url.Values{}
+		if options.Module != "" {
+			params.Add("module", options.Module)
+		}
+		if options.Limit != nil {
+			params.Add("limit", strconv.Itoa(*options.Limit))
+		}
+		if options.Offset != nil {
+			params.Add("offset", strconv.Itoa(*options.Offset))
+		}
+		if len(params) > 0 {
+			endpoint += "?" + params.Encode()
+		}
+	}
+
+	var response SafeModuleTransactionListResponse
+	err := api.makeRequest(ctx, "GET", endpoint, nil, &response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get module transactions: %w", err)
+	}
+
+	return &response, nil
+}
+
+// GetNextNonce returns the nonce to use for a new transaction: one past the highest
+// queued transaction, or the current Safe nonce when nothing is queued
+func (api *SafeApiKit) GetNextNonce(ctx context.Context, safeAddress string) (int64, error) {
+	safeInfo, err := api.GetSafeInfo(ctx, safeAddress)
+	if err != nil {
+		return 0, err
+	}
+	nonce, err := strconv.ParseInt(safeInfo.Nonce, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid Safe nonce %q: %w", safeInfo.Nonce, err)
+	}
+
+	params := url.Values{}
+	params.Add("executed", "false")
+	params.Add("nonce__gte", strconv.FormatInt(nonce, 10))
+	params.Add("ordering", "-nonce")
+	params.Add("limit", "1")
+	endpoint := fmt.Sprintf("/api/v1/safes/%s/multisig-transactions/?%s", safeAddress, params.Encode())
+
+	var queued SafeMultisigTransactionListResponse
+	err = api.makeRequest(ctx, "GET", endpoint, nil, &queued)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get queued transactions: %w", err)
+	}
+
+	if len(queued.Results) > 0 && queued.Results[0].Nonce >= nonce {
+		return queued.Results[0].Nonce + 1, nil
+	}
+	return nonce, nil
+}
+
+// AddMessage proposes a new off-chain message for the Safe, signed by one of its owners
+func (api *SafeApiKit) AddMessage(ctx context.Context, safeAddress string, options AddMessageOptions) error {
+	endpoint := fmt.Sprintf("/api/v1/safes/%s/messages/", safeAddress)
+
+	err := api.makeRequest(ctx, "POST", endpoint, options, nil)
+	if err != nil {
+		return fmt.Errorf("failed to add message: %w", err)
+	}
+
+	return nil
+}
+
+// GetMessage retrieves a Safe message by its Safe message hash
+func (api *SafeApiKit) GetMessage(ctx context.Context, messageHash string) (*SafeMessage, error) {
+	endpoint := fmt.Sprintf("/api/v1/messages/%s/", messageHash)
+
+	var response SafeMessage
+	err := api.makeRequest(ctx, "GET", endpoint, nil, &response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get message: %w", err)
+	}
+
+	return &response, nil
+}
+
+// GetMessages retrieves the messages of a Safe
+func (api *SafeApiKit) GetMessages(ctx context.Context, safeAddress string, options *GetSafeMessageListOptions) (*SafeMessageListResponse, error) {
+	endpoint := fmt.Sprintf("/api/v1/safes/%s/messages/", safeAddress)
+
+	if options != nil {
+		params := url.Values{}
+		if options.Limit != nil {
+			params.Add("limit", strconv.Itoa(*options.Limit))
+		}
+		if options.Offset != nil {
+			params.Add("offset", strconv.Itoa(*options.Offset))
+		}
+		if len(params) > 0 {
+			endpoint += "?" + params.Encode()
+		}
+	}
+
+	var response SafeMessageListResponse
+	err := api.makeRequest(ctx, "GET", endpoint, nil, &response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get messages: %w", err)
+	}
+
+	return &response, nil
+}
+
+// AddMessageSignature adds an owner's signature to an existing Safe message
+func (api *SafeApiKit) AddMessageSignature(ctx context.Context, messageHash string, signature string) error {
+	endpoint := fmt.Sprintf("/api/v1/messages/%s/signatures/", messageHash)
+
+	requestBody := map[string]string{
+		"signature": signature,
+	}
+
+	err := api.makeRequest(ctx, "POST", endpoint, requestBody, nil)
+	if err != nil {
+		return fmt.Errorf("failed to add message signature: %w", err)
+	}
+
+	return nil
+}
+
 // makeRequest makes an HTTP request to the Safe Transaction Service API
 func (api *SafeApiKit) makeRequest(ctx context.Context, method, endpoint string, body interface{}, result interface{}) error {
 	// Prepare request body
@@ -269,7 +507,7 @@ func (api *SafeApiKit) makeRequest(ctx context.Context, method, endpoint string,
 
 	// Check status code
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(responseBody))
+		return &APIError{StatusCode: resp.StatusCode, Body: string(responseBody)}
 	}
 
 	// Parse response - handle empty responses for successful operations
@@ -1,6 +1,8 @@
 package api
 
 import (
+	"encoding/json"
+	"fmt"
 	"time"
 )
 
@@ -50,6 +52,7 @@ type SafeMultisigTransactionResponse struct {
 	Confirmations           []SafeMultisigConfirmationResponse        `json:"confirmations"`
 	Trusted                 bool                                      `json:"trusted"`
 	Signatures              *string                                   `json:"signatures"`
+	SignaturesError         string                                    `json:"signaturesError,omitempty"` // Set by history scans when the signatures cannot be decoded
 }
 
 // SafeMultisigConfirmationResponse represents a transaction confirmation
@@ -191,7 +194,8 @@ type OwnerResponse struct {
 	Owners      []string `json:"owners"`
 }
 
-// ModulesResponse represents enabled modules
+// ModulesResponse represents enabled modules. The client does not return it (see
+// SafesByModuleResponse); it is kept so that code depending on it keeps compiling.
 type ModulesResponse struct {
 	SafeAddress string   `json:"safeAddress"`
 	Modules     []string `json:"modules"`
@@ -272,12 +276,77 @@ type AllTransactionsListResponse struct {
 	Results  []AllTransactionResult `json:"results"`
 }
 
-// AllTransactionResult represents any type of transaction result
+// AllTransactionResult represents any type of transaction result. The all-transactions
+// endpoint returns flat entries, so the struct is encoded by UnmarshalJSON and MarshalJSON
+// rather than by field tags. Raw holds the entry as returned by the service, including
+// entries of unknown type.
 type AllTransactionResult struct {
-	Type                    string                            `json:"type"` // "MULTISIG_TRANSACTION", "MODULE_TRANSACTION", "ETHEREUM_TRANSACTION"
-	MultisigTransaction     *SafeMultisigTransactionResponse  `json:"multisigTransaction,omitempty"`
-	ModuleTransaction       *SafeModuleTransactionResponse    `json:"moduleTransaction,omitempty"`
-	EthereumTransaction     *SafeEthereumTransactionResponse  `json:"ethereumTransaction,omitempty"`
+	Type                    string                            `json:"-"` // "MULTISIG_TRANSACTION", "MODULE_TRANSACTION", "ETHEREUM_TRANSACTION"
+	MultisigTransaction     *SafeMultisigTransactionResponse  `json:"-"`
+	ModuleTransaction       *SafeModuleTransactionResponse    `json:"-"`
+	EthereumTransaction     *SafeEthereumTransactionResponse  `json:"-"`
+	Raw                     json.RawMessage                   `json:"-"`
+}
+
+// UnmarshalJSON decodes an entry of the all-transactions endpoint, which holds the
+// fields of the transaction alongside its txType
+func (r *AllTransactionResult) UnmarshalJSON(data []byte) error {
+	var header struct {
+		TxType string `json:"txType"`
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return err
+	}
+
+	r.Type = header.TxType
+	r.Raw = append(json.RawMessage(nil), data...)
+	switch header.TxType {
+	case "MULTISIG_TRANSACTION":
+		r.MultisigTransaction = new(SafeMultisigTransactionResponse)
+		return json.Unmarshal(data, r.MultisigTransaction)
+	case "MODULE_TRANSACTION":
+		r.ModuleTransaction = new(SafeModuleTransactionResponse)
+		return json.Unmarshal(data, r.ModuleTransaction)
+	case "ETHEREUM_TRANSACTION":
+		r.EthereumTransaction = new(SafeEthereumTransactionResponse)
+		return json.Unmarshal(data, r.EthereumTransaction)
+	}
+	return nil
+}
+
+// MarshalJSON encodes the entry in the flat shape of the all-transactions endpoint.
+// Decoded entries are returned as received.
+func (r AllTransactionResult) MarshalJSON() ([]byte, error) {
+	if len(r.Raw) > 0 {
+		return r.Raw, nil
+	}
+
+	var tx interface{}
+	switch {
+	case r.MultisigTransaction != nil:
+		tx = r.MultisigTransaction
+	case r.ModuleTransaction != nil:
+		tx = r.ModuleTransaction
+	case r.EthereumTransaction != nil:
+		tx = r.EthereumTransaction
+	}
+
+	fields := make(map[string]json.RawMessage)
+	if tx != nil {
+		data, err := json.Marshal(tx)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(data, &fields); err != nil {
+			return nil, err
+		}
+	}
+	txType, err := json.Marshal(r.Type)
+	if err != nil {
+		return nil, err
+	}
+	fields["txType"] = txType
+	return json.Marshal(fields)
 }
 
 // SafeModuleTransactionResponse represents a module transaction
@@ -314,4 +383,34 @@ type AllTransactionsOptions struct {
 	Trusted     *bool   `json:"trusted,omitempty"`
 	Limit       *int    `json:"limit,omitempty"`
 	Offset      *int    `json:"offset,omitempty"`
+}
+
+// SafesByModuleResponse represents Safes that have a module enabled
+type SafesByModuleResponse struct {
+	Safes []string `json:"safes"`
+}
+
+// SafeModuleTransactionListResponse represents a list of module transactions
+type SafeModuleTransactionListResponse struct {
+	Count    int                             `json:"count"`
+	Next     *string                         `json:"next"`
+	Previous *string                         `json:"previous"`
+	Results  []SafeModuleTransactionResponse `json:"results"`
+}
+
+// GetModuleTransactionsOptions represents options for getting module transactions
+type GetModuleTransactionsOptions struct {
+	Module string `json:"module,omitempty"` // Only transactions of this module
+	Limit  *int   `json:"limit,omitempty"`
+	Offset *int   `json:"offset,omitempty"`
+}
+
+// APIError is returned when the Transaction Service answers with a non-2xx status
+type APIError struct {
+	StatusCode int    // HTTP status code
+	Body       string // Response body
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("API request failed with status %d: %s", e.StatusCode, e.Body)
 }
\ No newline at end of file
@@ -23,6 +23,7 @@ import (
 	"github.com/joho/godotenv"
 	"github.com/vikkkko/safe-core-sdk-golang/api"
 	"github.com/vikkkko/safe-core-sdk-golang/protocol"
+	"github.com/vikkkko/safe-core-sdk-golang/protocol/enterprise"
 	"github.com/vikkkko/safe-core-sdk-golang/protocol/utils"
 	safetypes "github.com/vikkkko/safe-core-sdk-golang/types"
 )
@@ -423,6 +424,7 @@ func showMenu() {
 	fmt.Println("\nEmergency Controls:")
 	fmt.Println("  14. Emergency freeze/unfreeze")
 	fmt.Println("  15. Emergency pause/unpause")
+	fmt.Println("  28. Rescue funds stranded on the wallet contract")
 	fmt.Println("\nSuperAdmin Transfer:")
 	fmt.Println("  16. Propose SuperAdmin transfer")
 	fmt.Println("  17. Confirm SuperAdmin transfer")
@@ -543,6 +545,8 @@ func runExample(ctx *ExampleContext, choice string) {
 		paymentAccountApprove(ctx)
 	case "27":
 		confirmSafeTransactionSDK(ctx)
+	case "28":
+		rescueFunds(ctx)
 	default:
 		fmt.Println("Invalid choice.")
 	}
@@ -1777,6 +1781,73 @@ func emergencyPause(ctx *ExampleContext) {
 	waitForTransaction(ctx, tx)
 }
 
+func rescueFunds(ctx *ExampleContext) {
+	fmt.Println("=== Rescue Stranded Funds ===")
+
+	walletAddr := promptAddress("Enterprise wallet address", "")
+	tokensStr := prompt("ERC20 tokens to check (comma-separated, ETH is always checked)")
+	var tokens []common.Address
+	for _, token := range strings.Split(tokensStr, ",") {
+		if token = strings.TrimSpace(token); token != "" {
+			tokens = append(tokens, common.HexToAddress(token))
+		}
+	}
+
+	wallet, err := enterprise.NewEnterpriseWalletClient(walletAddr, ctx.Client)
+	if err != nil {
+		log.Printf("Error: %v", err)
+		return
+	}
+
+	// rescueFunds pays the caller, which must be the super admin
+	plan, err := wallet.PlanRescue(context.Background(), tokens, ctx.FromAddress)
+	if err != nil {
+		log.Printf("Error: %v", err)
+		return
+	}
+	if plan.IsEmpty() {
+		fmt.Println("No stranded funds found.")
+		return
+	}
+	fmt.Printf("\n%s", plan)
+
+	if !confirmSend() {
+		fmt.Println("Cancelled.")
+		return
+	}
+
+	// Let each call pick its own nonce and gas limit
+	auth := getAuth(ctx, nil, nil)
+	auth.Nonce = nil
+	auth.GasLimit = 0
+
+	results, err := wallet.ExecuteRescue(context.Background(), enterprise.NewEOASender(ctx.Client, auth), plan)
+	if err != nil {
+		log.Printf("Error: %v", err)
+		return
+	}
+
+	var receipts []*gethtypes.Receipt
+	for _, result := range results {
+		fmt.Printf("\nTransaction sent: %s\n", result.Transaction.Hash().Hex())
+		receipt, err := bind.WaitMined(context.Background(), ctx.Client, result.Transaction)
+		if err != nil {
+			log.Printf("Error waiting for transaction: %v", err)
+			return
+		}
+		receipts = append(receipts, receipt)
+	}
+
+	events, err := wallet.VerifyRescue(plan, receipts...)
+	if err != nil {
+		log.Printf("Error: %v", err)
+		return
+	}
+	for _, event := range events {
+		fmt.Printf("✓ Rescued %s of %s to %s\n", event.Amount, event.Token.Hex(), event.To.Hex())
+	}
+}
+
 func proposeSuperAdminTransfer(ctx *ExampleContext) {
 	fmt.Println("=== Propose SuperAdmin Transfer ===")
 
@@ -270,8 +270,11 @@ func queryTransactionInfo(client *graphql.Client) {
 
 	info, err := client.GetTransactionInfo(ctx, txHashLower)
 	if err != nil {
-		log.Printf("Failed to query transaction info: %v", err)
-		return
+		if info == nil {
+			log.Printf("Failed to query transaction info: %v", err)
+			return
+		}
+		log.Printf("Warning: showing indexed data only: %v", err)
 	}
 
 	fmt.Println("\n=== Transaction Details ===")
@@ -281,6 +284,9 @@ func queryTransactionInfo(client *graphql.Client) {
 	fmt.Printf("Gas Limit:      %s\n", fallbackValue(info.GasLimit))
 	fmt.Printf("Gas Used:       %s\n", fallbackValue(info.GasUsed))
 	fmt.Printf("Gas Price:      %s\n", info.GasPrice)
+	if info.EffectiveGasPrice != "" {
+		fmt.Printf("Effective Price:%s\n", info.EffectiveGasPrice)
+	}
 	fmt.Printf("Transaction Fee:%s\n", fallbackValue(info.TransactionFee))
 
 	if ts, err := strconv.ParseInt(info.Timestamp, 10, 64); err == nil {
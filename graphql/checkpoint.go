@@ -0,0 +1,126 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Cursor marks the last record delivered by a watcher. Records are ordered by
+// timestamp and then by id.
+type Cursor struct {
+	Timestamp string `json:"timestamp"`
+	ID        string `json:"id"`
+}
+
+// CheckpointStore persists watcher cursors so a restarted watcher resumes where it stopped
+type CheckpointStore interface {
+	// Load returns the cursor saved under key, or false when there is none
+	Load(ctx context.Context, key string) (Cursor, bool, error)
+	// Save stores the cursor under key
+	Save(ctx context.Context, key string, cursor Cursor) error
+}
+
+// MemoryCheckpointStore keeps cursors in memory. It is the default store and
+// does not survive restarts.
+type MemoryCheckpointStore struct {
+	mu      sync.Mutex
+	cursors map[string]Cursor
+}
+
+// NewMemoryCheckpointStore creates an empty in-memory checkpoint store
+func NewMemoryCheckpointStore() *MemoryCheckpointStore {
+	return &MemoryCheckpointStore{cursors: make(map[string]Cursor)}
+}
+
+// Load returns the cursor saved under key
+func (s *MemoryCheckpointStore) Load(ctx context.Context, key string) (Cursor, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cursor, ok := s.cursors[key]
+	return cursor, ok, nil
+}
+
+// Save stores the cursor under key
+func (s *MemoryCheckpointStore) Save(ctx context.Context, key string, cursor Cursor) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cursors[key] = cursor
+	return nil
+}
+
+// FileCheckpointStore keeps cursors in a JSON file
+type FileCheckpointStore struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewFileCheckpointStore creates a checkpoint store backed by the JSON file at path.
+// The file is created on the first Save.
+func NewFileCheckpointStore(path string) *FileCheckpointStore {
+	return &FileCheckpointStore{path: path}
+}
+
+// Load returns the cursor saved under key
+func (s *FileCheckpointStore) Load(ctx context.Context, key string) (Cursor, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cursors, err := s.read()
+	if err != nil {
+		return Cursor{}, false, err
+	}
+	cursor, ok := cursors[key]
+	return cursor, ok, nil
+}
+
+// Save stores the cursor under key, replacing the file atomically
+func (s *FileCheckpointStore) Save(ctx context.Context, key string, cursor Cursor) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cursors, err := s.read()
+	if err != nil {
+		return err
+	}
+	cursors[key] = cursor
+
+	data, err := json.MarshalIndent(cursors, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal checkpoints: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*")
+	if err != nil {
+		return fmt.Errorf("failed to write checkpoints: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write checkpoints: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write checkpoints: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("failed to write checkpoints: %w", err)
+	}
+	return nil
+}
+
+func (s *FileCheckpointStore) read() (map[string]Cursor, error) {
+	cursors := make(map[string]Cursor)
+	data, err := os.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return cursors, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read checkpoints: %w", err)
+	}
+	if err := json.Unmarshal(data, &cursors); err != nil {
+		return nil, fmt.Errorf("failed to parse checkpoints: %w", err)
+	}
+	return cursors, nil
+}
@@ -4,34 +4,68 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
-	"math/big"
+	"iter"
+	"math/rand/v2"
 	"net/http"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 
-	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
 )
 
+// maxErrorBody limits the response body kept in an HTTPError
+const maxErrorBody = 512
+
 // Client represents a GraphQL client for querying subgraph data
 type Client struct {
-	endpoint   string
-	httpClient *http.Client
-	rpcURL     string
+	endpoint    string
+	httpClient  *http.Client
+	rpcURL      string
+	retry       RetryConfig
+	block       *uint64 // Block typed queries are pinned to, nil for the latest indexed block
+	checkpoints CheckpointStore
+
+	rpc *rpcConn
+}
 
-	ethClient   *ethclient.Client
-	ethClientMu sync.Mutex
+// rpcConn is the lazily dialed RPC client, shared by the copies returned by AtBlock
+type rpcConn struct {
+	mu     sync.Mutex
+	raw    *rpc.Client // Used for batched calls
+	client *ethclient.Client
 }
 
 // Config represents configuration for GraphQL client
 type Config struct {
-	Endpoint string        // GraphQL endpoint URL
-	RPCURL   string        // Optional RPC endpoint for receipt enrichment
-	Timeout  time.Duration // HTTP timeout (default: 30s)
+	Endpoint    string          // GraphQL endpoint URL
+	RPCURL      string          // Optional RPC endpoint for receipt enrichment
+	Timeout     time.Duration   // HTTP timeout (default: 30s)
+	Retry       RetryConfig     // Retries of transient failures (default: 3 retries)
+	Checkpoints CheckpointStore // Cursor store of watchers (default: in memory)
+}
+
+// RetryConfig controls retries of rate limited, 5xx and "indexing behind" failures.
+// Delays grow exponentially from BaseDelay up to MaxDelay with full jitter.
+type RetryConfig struct {
+	MaxRetries int           // Retries after the first attempt (default: 3, negative disables retries)
+	BaseDelay  time.Duration // Delay before the first retry (default: 500ms)
+	MaxDelay   time.Duration // Upper bound of a single delay (default: 10s)
+}
+
+// DefaultRetryConfig returns the default retry configuration
+func DefaultRetryConfig() RetryConfig {
+	return RetryConfig{
+		MaxRetries: 3,
+		BaseDelay:  500 * time.Millisecond,
+		MaxDelay:   10 * time.Second,
+	}
 }
 
 // DefaultConfig returns default GraphQL configuration
@@ -39,6 +73,7 @@ func DefaultConfig() Config {
 	return Config{
 		Endpoint: "https://api.studio.thegraph.com/query/103887/mvp/version/latest",
 		Timeout:  30 * time.Second,
+		Retry:    DefaultRetryConfig(),
 	}
 }
 
@@ -48,12 +83,30 @@ func NewClient(config Config) *Client {
 		config.Timeout = 30 * time.Second
 	}
 
+	defaults := DefaultRetryConfig()
+	if config.Retry.MaxRetries == 0 {
+		config.Retry.MaxRetries = defaults.MaxRetries
+	}
+	if config.Retry.BaseDelay == 0 {
+		config.Retry.BaseDelay = defaults.BaseDelay
+	}
+	if config.Retry.MaxDelay == 0 {
+		config.Retry.MaxDelay = defaults.MaxDelay
+	}
+
+	if config.Checkpoints == nil {
+		config.Checkpoints = NewMemoryCheckpointStore()
+	}
+
 	return &Client{
-		endpoint: config.Endpoint,
-		rpcURL:   config.RPCURL,
+		endpoint:    config.Endpoint,
+		rpcURL:      config.RPCURL,
+		retry:       config.Retry,
+		checkpoints: config.Checkpoints,
 		httpClient: &http.Client{
 			Timeout: config.Timeout,
 		},
+		rpc: &rpcConn{},
 	}
 }
 
@@ -62,7 +115,10 @@ func NewDefaultClient() *Client {
 	return NewClient(DefaultConfig())
 }
 
-// Query executes a GraphQL query and returns the raw response
+// Query executes a GraphQL query and returns the raw response. Transient failures
+// are retried as configured in Config.Retry. GraphQL errors are returned as a
+// *GraphQLError; when the response also holds partial data the body is returned
+// together with the error.
 func (c *Client) Query(ctx context.Context, query string, variables map[string]interface{}) ([]byte, error) {
 	reqBody := GraphQLRequest{
 		Query:     query,
@@ -74,7 +130,21 @@ func (c *Client) Query(ctx context.Context, query string, variables map[string]i
 		return nil, fmt.Errorf("failed to marshal request: %w", err)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, "POST", c.endpoint, bytes.NewBuffer(jsonData))
+	for attempt := 0; ; attempt++ {
+		body, err := c.post(ctx, jsonData)
+		if err == nil || attempt >= c.retry.MaxRetries || !IsRetryable(err) {
+			return body, err
+		}
+
+		if err := sleepContext(ctx, c.retryDelay(attempt, err)); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// post sends one request and checks the response for errors
+func (c *Client) post(ctx context.Context, jsonData []byte) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", c.endpoint, bytes.NewReader(jsonData))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -83,17 +153,24 @@ func (c *Client) Query(ctx context.Context, query string, variables map[string]i
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to execute request: %w", err)
+		return nil, &requestError{err: err}
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
-	}
-
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return nil, &requestError{err: fmt.Errorf("failed to read response body: %w", err)}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		httpErr := &HTTPError{StatusCode: resp.StatusCode, Body: string(body)}
+		if len(httpErr.Body) > maxErrorBody {
+			httpErr.Body = httpErr.Body[:maxErrorBody] + "..."
+		}
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds > 0 {
+			httpErr.RetryAfter = time.Duration(seconds) * time.Second
+		}
+		return nil, httpErr
 	}
 
 	// Check for GraphQL errors
@@ -103,215 +180,252 @@ func (c *Client) Query(ctx context.Context, query string, variables map[string]i
 	}
 
 	if len(graphqlResp.Errors) > 0 {
-		return nil, fmt.Errorf("GraphQL error: %s", graphqlResp.Errors[0].Message)
+		graphqlErr := &GraphQLError{Errors: graphqlResp.Errors, Data: graphqlResp.Data}
+		if graphqlErr.HasData() {
+			return body, graphqlErr
+		}
+		return nil, graphqlErr
 	}
 
 	return body, nil
 }
 
-// GetPaymentAllowances queries allowances granted to a specific payment account
+// retryDelay returns the jittered exponential backoff before retry attempt+1,
+// honoring Retry-After when the server sent one
+func (c *Client) retryDelay(attempt int, err error) time.Duration {
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) && httpErr.RetryAfter > 0 {
+		return min(httpErr.RetryAfter, c.retry.MaxDelay)
+	}
+
+	backoff := c.retry.MaxDelay
+	if attempt < 32 {
+		backoff = min(c.retry.BaseDelay<<attempt, c.retry.MaxDelay)
+	}
+	return time.Duration(rand.Int64N(int64(backoff) + 1))
+}
+
+// sleepContext waits for d or until ctx is done
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// GetPaymentAllowances queries all allowances granted to a specific payment account
 // Parameters:
 //   - paymentAccount: The address of the payment account to query allowances for
 //
 // Returns:
 //   - List of allowances showing which addresses have granted approvals to this payment account
 func (c *Client) GetPaymentAllowances(ctx context.Context, paymentAccount string) ([]PaymentAllowance, error) {
+	return c.GetAllPaymentAllowances(ctx, paymentAccount, PaginateOptions{})
+}
+
+// GetPaymentAllowancesPage queries one page of allowances granted to a payment account
+func (c *Client) GetPaymentAllowancesPage(ctx context.Context, paymentAccount string, opts ListOptions) ([]PaymentAllowance, error) {
 	accountID := normalizeAddress(paymentAccount)
 	if accountID == "" {
 		return nil, fmt.Errorf("invalid payment account address: %s", paymentAccount)
 	}
 
-	query := `
-		query GetPaymentAllowances($paymentAccount: String!) {
-			paymentAllowances(where: {paymentAccount: $paymentAccount}) {
-				token
-				owner
-				amount
-			}
-		}
-	`
-
 	variables := map[string]interface{}{
 		"paymentAccount": accountID,
 	}
+	query, err := listQuery("GetPaymentAllowances", "paymentAllowances", "$paymentAccount: String!",
+		[]string{"paymentAccount: $paymentAccount"}, "id token owner amount", opts, c.block, variables)
+	if err != nil {
+		return nil, err
+	}
 
-	respBody, err := c.Query(ctx, query, variables)
+	data, err := Do[PaymentAllowancesData](ctx, c, query, variables)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query payment allowances: %w", err)
 	}
 
-	var response PaymentAllowancesResponse
-	if err := json.Unmarshal(respBody, &response); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
+	return data.PaymentAllowances, nil
+}
+
+// GetAllPaymentAllowances pages through the allowances granted to a payment account
+func (c *Client) GetAllPaymentAllowances(ctx context.Context, paymentAccount string, opts PaginateOptions) ([]PaymentAllowance, error) {
+	return collect(c.IteratePaymentAllowances(ctx, paymentAccount, opts))
+}
 
-	return response.Data.PaymentAllowances, nil
+// IteratePaymentAllowances yields the allowances granted to a payment account, fetching pages on demand
+func (c *Client) IteratePaymentAllowances(ctx context.Context, paymentAccount string, opts PaginateOptions) iter.Seq2[PaymentAllowance, error] {
+	return paginate(ctx, opts, func(a PaymentAllowance) string { return a.ID },
+		func(ctx context.Context, page ListOptions) ([]PaymentAllowance, error) {
+			return c.GetPaymentAllowancesPage(ctx, paymentAccount, page)
+		})
 }
 
-// GetPaymentApprovals queries approvals granted by a payment account
+// GetPaymentApprovals queries all approvals granted by a payment account, newest first
 func (c *Client) GetPaymentApprovals(ctx context.Context, account string) ([]PaymentApproval, error) {
+	approvals, err := c.GetAllPaymentApprovals(ctx, account, PaginateOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(approvals, func(i, j int) bool {
+		ti, _ := strconv.ParseInt(approvals[i].Timestamp, 10, 64)
+		tj, _ := strconv.ParseInt(approvals[j].Timestamp, 10, 64)
+		return ti > tj
+	})
+	return approvals, nil
+}
+
+// GetPaymentApprovalsPage queries one page of approvals granted by a payment account
+func (c *Client) GetPaymentApprovalsPage(ctx context.Context, account string, opts ListOptions) ([]PaymentApproval, error) {
 	accountID := normalizeAddress(account)
 	if accountID == "" {
 		return nil, fmt.Errorf("invalid payment account address: %s", account)
 	}
 
-	query := `
-		query GetPaymentApprovals($account: String!) {
-			paymentApprovals(
-				where: {account: $account}
-				orderBy: timestamp
-				orderDirection: desc
-			) {
-				token
-				spender
-				amount
-				timestamp
-				txHash
-			}
-		}
-	`
-
 	variables := map[string]interface{}{
 		"account": accountID,
 	}
-
-	respBody, err := c.Query(ctx, query, variables)
+	query, err := listQuery("GetPaymentApprovals", "paymentApprovals", "$account: String!",
+		[]string{"account: $account"}, "id token spender amount timestamp txHash", opts, c.block, variables)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query payment approvals: %w", err)
-	}
-
-	var response PaymentApprovalsResponse
-	if err := json.Unmarshal(respBody, &response); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal payment approvals response: %w", err)
+		return nil, err
 	}
 
-	return response.Data.PaymentApprovals, nil
+	return c.queryPaymentApprovals(ctx, query, variables)
 }
 
-// GetTransactionInfo queries a transaction by its hash
-func (c *Client) GetTransactionInfo(ctx context.Context, txHash string) (*TransactionInfo, error) {
-	query := `
-		query GetTransactionInfo($id: ID!) {
-			transactionInfo(id: $id) {
-				id
-				blockNumber
-				gasLimit
-				gasPrice
-				gasUsed
-				status
-				timestamp
-				transactionFee
-			}
-		}
-	`
-
-	variables := map[string]interface{}{
-		"id": txHash,
-	}
-
-	respBody, err := c.Query(ctx, query, variables)
+func (c *Client) queryPaymentApprovals(ctx context.Context, query string, variables map[string]interface{}) ([]PaymentApproval, error) {
+	data, err := Do[PaymentApprovalsData](ctx, c, query, variables)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query transaction info: %w", err)
+		return nil, fmt.Errorf("failed to query payment approvals: %w", err)
 	}
 
-	var response TransactionInfoResponse
-	if err := json.Unmarshal(respBody, &response); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal transaction info response: %w", err)
-	}
+	return data.PaymentApprovals, nil
+}
 
-	if response.Data.TransactionInfo == nil {
-		return nil, fmt.Errorf("transaction %s not found", txHash)
-	}
+// GetAllPaymentApprovals pages through the approvals granted by a payment account in id order
+func (c *Client) GetAllPaymentApprovals(ctx context.Context, account string, opts PaginateOptions) ([]PaymentApproval, error) {
+	return collect(c.IteratePaymentApprovals(ctx, account, opts))
+}
 
-	if c.rpcURL != "" {
-		if err := c.enrichTransactionInfo(ctx, response.Data.TransactionInfo); err != nil {
-			// Do not fail the call if receipt lookup fails – return partial graph data
-			// while still surfacing the primary result.
-		}
-	}
+// IteratePaymentApprovals yields the approvals granted by a payment account, fetching pages on demand
+func (c *Client) IteratePaymentApprovals(ctx context.Context, account string, opts PaginateOptions) iter.Seq2[PaymentApproval, error] {
+	return paginate(ctx, opts, func(a PaymentApproval) string { return a.ID },
+		func(ctx context.Context, page ListOptions) ([]PaymentApproval, error) {
+			return c.GetPaymentApprovalsPage(ctx, account, page)
+		})
+}
 
-	return response.Data.TransactionInfo, nil
+// GetTransactionInfo queries a transaction by its hash. When RPCURL is configured
+// and the receipt lookup fails, the indexed data is returned together with the error.
+func (c *Client) GetTransactionInfo(ctx context.Context, txHash string) (*TransactionInfo, error) {
+	results, err := c.GetTransactionInfos(ctx, []string{txHash})
+	if err != nil {
+		return nil, err
+	}
+	return results[0].Info, results[0].Err
 }
 
+// accountBalanceFields selects an account with all of its token balances
+const accountBalanceFields = `
+				id
+				tokenBalances(first: 1000) {
+					balance
+					updatedAt
+					token {
+						id
+						name
+						symbol
+						decimals
+					}
+				}`
+
 // GetPaymentAccounts retrieves payment accounts with their token balances.
 // accountIDs accepts one or more account addresses (case-insensitive).
 func (c *Client) GetPaymentAccounts(ctx context.Context, accountIDs []string) ([]PaymentAccount, error) {
+	return c.GetAllPaymentAccounts(ctx, accountIDs, PaginateOptions{})
+}
+
+// GetPaymentAccountsPage retrieves one page of the given payment accounts
+func (c *Client) GetPaymentAccountsPage(ctx context.Context, accountIDs []string, opts ListOptions) ([]PaymentAccount, error) {
 	if len(accountIDs) == 0 {
 		return []PaymentAccount{}, nil
 	}
 
-	query := `
-        query GetPaymentAccounts($ids: [ID!]) {
-            paymentAccounts(where: {id_in: $ids}) {
-                id
-                tokenBalances {
-                    balance
-                    updatedAt
-                    token {
-                        name
-                        symbol
-                        decimals
-                    }
-                }
-            }
-        }
-    `
-
 	variables := map[string]interface{}{
 		"ids": normalizeAddresses(accountIDs),
 	}
+	query, err := listQuery("GetPaymentAccounts", "paymentAccounts", "$ids: [ID!]",
+		[]string{"id_in: $ids"}, accountBalanceFields, opts, c.block, variables)
+	if err != nil {
+		return nil, err
+	}
 
-	respBody, err := c.Query(ctx, query, variables)
+	data, err := Do[PaymentAccountsData](ctx, c, query, variables)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query payment accounts: %w", err)
 	}
 
-	var response PaymentAccountsResponse
-	if err := json.Unmarshal(respBody, &response); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal payment accounts response: %w", err)
-	}
+	return data.PaymentAccounts, nil
+}
+
+// GetAllPaymentAccounts pages through the given payment accounts
+func (c *Client) GetAllPaymentAccounts(ctx context.Context, accountIDs []string, opts PaginateOptions) ([]PaymentAccount, error) {
+	return collect(c.IteratePaymentAccounts(ctx, accountIDs, opts))
+}
 
-	return response.Data.PaymentAccounts, nil
+// IteratePaymentAccounts yields the given payment accounts, fetching pages on demand
+func (c *Client) IteratePaymentAccounts(ctx context.Context, accountIDs []string, opts PaginateOptions) iter.Seq2[PaymentAccount, error] {
+	return paginate(ctx, opts, func(a PaymentAccount) string { return a.ID },
+		func(ctx context.Context, page ListOptions) ([]PaymentAccount, error) {
+			return c.GetPaymentAccountsPage(ctx, accountIDs, page)
+		})
 }
 
 // GetCollectionAccounts retrieves collection accounts with their token balances.
 func (c *Client) GetCollectionAccounts(ctx context.Context, accountIDs []string) ([]CollectionAccount, error) {
+	return c.GetAllCollectionAccounts(ctx, accountIDs, PaginateOptions{})
+}
+
+// GetCollectionAccountsPage retrieves one page of the given collection accounts
+func (c *Client) GetCollectionAccountsPage(ctx context.Context, accountIDs []string, opts ListOptions) ([]CollectionAccount, error) {
 	if len(accountIDs) == 0 {
 		return []CollectionAccount{}, nil
 	}
 
-	query := `
-        query GetCollectionAccounts($ids: [ID!]) {
-            collectionAccounts(where: {id_in: $ids}) {
-                id
-                tokenBalances {
-                    balance
-                    updatedAt
-                    token {
-                        name
-                        symbol
-                        decimals
-                    }
-                }
-            }
-        }
-    `
-
 	variables := map[string]interface{}{
 		"ids": normalizeAddresses(accountIDs),
 	}
+	query, err := listQuery("GetCollectionAccounts", "collectionAccounts", "$ids: [ID!]",
+		[]string{"id_in: $ids"}, accountBalanceFields, opts, c.block, variables)
+	if err != nil {
+		return nil, err
+	}
 
-	respBody, err := c.Query(ctx, query, variables)
+	data, err := Do[CollectionAccountsData](ctx, c, query, variables)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query collection accounts: %w", err)
 	}
 
-	var response CollectionAccountsResponse
-	if err := json.Unmarshal(respBody, &response); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal collection accounts response: %w", err)
-	}
+	return data.CollectionAccounts, nil
+}
+
+// GetAllCollectionAccounts pages through the given collection accounts
+func (c *Client) GetAllCollectionAccounts(ctx context.Context, accountIDs []string, opts PaginateOptions) ([]CollectionAccount, error) {
+	return collect(c.IterateCollectionAccounts(ctx, accountIDs, opts))
+}
 
-	return response.Data.CollectionAccounts, nil
+// IterateCollectionAccounts yields the given collection accounts, fetching pages on demand
+func (c *Client) IterateCollectionAccounts(ctx context.Context, accountIDs []string, opts PaginateOptions) iter.Seq2[CollectionAccount, error] {
+	return paginate(ctx, opts, func(a CollectionAccount) string { return a.ID },
+		func(ctx context.Context, page ListOptions) ([]CollectionAccount, error) {
+			return c.GetCollectionAccountsPage(ctx, accountIDs, page)
+		})
 }
 
 // GetPaymentAuthorizations retrieves both allowances and approvals for a payment account.
@@ -334,61 +448,42 @@ func (c *Client) GetPaymentAuthorizations(ctx context.Context, account string) (
 
 // Close releases any resources held by the client.
 func (c *Client) Close() {
-	c.ethClientMu.Lock()
-	defer c.ethClientMu.Unlock()
-	if c.ethClient != nil {
-		c.ethClient.Close()
-		c.ethClient = nil
+	c.rpc.mu.Lock()
+	defer c.rpc.mu.Unlock()
+	if c.rpc.client != nil {
+		c.rpc.client.Close()
+		c.rpc.client = nil
+		c.rpc.raw = nil
 	}
 }
 
-func (c *Client) enrichTransactionInfo(ctx context.Context, info *TransactionInfo) error {
-	ethClient, err := c.getEthClient(ctx)
-	if err != nil {
-		return err
-	}
-
-	receipt, err := ethClient.TransactionReceipt(ctx, common.HexToHash(info.ID))
-	if err != nil {
-		return err
-	}
-
-	info.GasUsed = strconv.FormatUint(receipt.GasUsed, 10)
-	info.Status = strconv.FormatUint(uint64(receipt.Status), 10)
-
-	if info.GasLimit == "" {
-		info.GasLimit = strconv.FormatUint(receipt.GasUsed, 10)
-	}
-
-	if info.TransactionFee == "" {
-		if gasPrice, ok := new(big.Int).SetString(info.GasPrice, 10); ok {
-			fee := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(receipt.GasUsed))
-			info.TransactionFee = fee.String()
-		}
+func (c *Client) getEthClient(ctx context.Context) (*ethclient.Client, error) {
+	if _, err := c.getRPCClient(ctx); err != nil {
+		return nil, err
 	}
-
-	return nil
+	return c.rpc.client, nil
 }
 
-func (c *Client) getEthClient(ctx context.Context) (*ethclient.Client, error) {
-	c.ethClientMu.Lock()
-	defer c.ethClientMu.Unlock()
+func (c *Client) getRPCClient(ctx context.Context) (*rpc.Client, error) {
+	c.rpc.mu.Lock()
+	defer c.rpc.mu.Unlock()
 
 	if c.rpcURL == "" {
 		return nil, fmt.Errorf("rpc url not configured")
 	}
 
-	if c.ethClient != nil {
-		return c.ethClient, nil
+	if c.rpc.raw != nil {
+		return c.rpc.raw, nil
 	}
 
-	client, err := ethclient.DialContext(ctx, c.rpcURL)
+	raw, err := rpc.DialContext(ctx, c.rpcURL)
 	if err != nil {
 		return nil, err
 	}
 
-	c.ethClient = client
-	return c.ethClient, nil
+	c.rpc.raw = raw
+	c.rpc.client = ethclient.NewClient(raw)
+	return c.rpc.raw, nil
 }
 
 func normalizeAddresses(addresses []string) []string {
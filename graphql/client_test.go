@@ -3,7 +3,19 @@ package graphql
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
 func TestNewClient(t *testing.T) {
@@ -153,3 +165,608 @@ func TestPaymentAllowance_UnmarshalJSON(t *testing.T) {
 		t.Errorf("Expected amount 9999999999999999999999, got %s", allowance.Amount.String())
 	}
 }
+
+// approvalsServer serves paymentApprovals from records honoring first and id_gt
+func approvalsServer(t *testing.T, records []PaymentApproval, requests *[]map[string]interface{}) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+			return
+		}
+		*requests = append(*requests, req.Variables)
+
+		first := int(req.Variables["first"].(float64))
+		after, _ := req.Variables["after"].(string)
+		page := []PaymentApproval{}
+		for _, record := range records {
+			if record.ID > after && len(page) < first {
+				page = append(page, record)
+			}
+		}
+
+		resp := PaymentApprovalsResponse{}
+		resp.Data.PaymentApprovals = page
+		json.NewEncoder(w).Encode(resp)
+	}))
+}
+
+func TestIteratePaymentApprovals(t *testing.T) {
+	var records []PaymentApproval
+	for i := 1; i <= 5; i++ {
+		records = append(records, PaymentApproval{
+			ID:        fmt.Sprintf("0x%02d", i),
+			Amount:    BigInt{big.NewInt(int64(i))},
+			Timestamp: fmt.Sprint(1000 + i),
+		})
+	}
+
+	var requests []map[string]interface{}
+	server := approvalsServer(t, records, &requests)
+	defer server.Close()
+
+	client := NewClient(Config{Endpoint: server.URL})
+	account := "0x0000000000000000000000000000000000000001"
+
+	all, err := client.GetAllPaymentApprovals(context.Background(), account, PaginateOptions{PageSize: 2})
+	if err != nil {
+		t.Fatalf("Failed to page approvals: %v", err)
+	}
+	if len(all) != 5 || len(requests) != 3 {
+		t.Errorf("Expected 5 approvals in 3 requests, got %d in %d", len(all), len(requests))
+	}
+	if requests[1]["after"] != "0x02" {
+		t.Errorf("Expected second page after 0x02, got %v", requests[1]["after"])
+	}
+
+	requests = nil
+	limited, err := client.GetAllPaymentApprovals(context.Background(), account, PaginateOptions{PageSize: 2, MaxResults: 3})
+	if err != nil {
+		t.Fatalf("Failed to page approvals: %v", err)
+	}
+	if len(limited) != 3 || requests[len(requests)-1]["first"].(float64) != 1 {
+		t.Errorf("Expected 3 approvals with a last page of 1, got %d", len(limited))
+	}
+
+	// The legacy getter returns everything, newest first
+	newest, err := client.GetPaymentApprovals(context.Background(), account)
+	if err != nil {
+		t.Fatalf("Failed to get approvals: %v", err)
+	}
+	if len(newest) != 5 || newest[0].ID != "0x05" {
+		t.Errorf("Expected 5 approvals newest first, got %+v", newest)
+	}
+
+	if _, err := client.GetPaymentApprovalsPage(context.Background(), account, ListOptions{First: MaxPageSize + 1}); err == nil {
+		t.Error("Expected an error for a page larger than the subgraph maximum")
+	}
+}
+
+func TestQueryRetriesTransientFailures(t *testing.T) {
+	responses := []func(w http.ResponseWriter){
+		func(w http.ResponseWriter) { w.WriteHeader(http.StatusTooManyRequests) },
+		func(w http.ResponseWriter) { w.WriteHeader(http.StatusBadGateway) },
+		func(w http.ResponseWriter) {
+			w.Write([]byte(`{"errors":[{"message":"subgraph has only indexed up to block number 10"}]}`))
+		},
+		func(w http.ResponseWriter) { w.Write([]byte(`{"data":{"ok":true}}`)) },
+	}
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		responses[attempts](w)
+		attempts++
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Endpoint: server.URL, Retry: RetryConfig{BaseDelay: time.Millisecond}})
+	body, err := client.Query(context.Background(), "{ ok }", nil)
+	if err != nil {
+		t.Fatalf("Expected the query to succeed after retries: %v", err)
+	}
+	if attempts != 4 || !strings.Contains(string(body), "ok") {
+		t.Errorf("Expected 4 attempts, got %d", attempts)
+	}
+
+	attempts = 0
+	client = NewClient(Config{Endpoint: server.URL, Retry: RetryConfig{MaxRetries: 1, BaseDelay: time.Millisecond}})
+	_, err = client.Query(context.Background(), "{ ok }", nil)
+	var httpErr *HTTPError
+	if !errors.As(err, &httpErr) || httpErr.StatusCode != http.StatusBadGateway || attempts != 2 {
+		t.Errorf("Expected a 502 after 2 attempts, got %v after %d", err, attempts)
+	}
+}
+
+func TestQueryReturnsAllGraphQLErrors(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Write([]byte(`{
+			"data": {"a": {"id": "1"}, "b": null},
+			"errors": [
+				{"message": "first", "locations": [{"line": 2, "column": 3}], "path": ["b"]},
+				{"message": "second", "path": ["b", 0, "id"]}
+			]
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Endpoint: server.URL, Retry: RetryConfig{BaseDelay: time.Millisecond}})
+	body, err := client.Query(context.Background(), "{ a b }", nil)
+
+	var graphqlErr *GraphQLError
+	if !errors.As(err, &graphqlErr) {
+		t.Fatalf("Expected a GraphQLError, got %v", err)
+	}
+	if attempts != 1 {
+		t.Errorf("Expected no retries for a permanent error, got %d attempts", attempts)
+	}
+	if len(graphqlErr.Errors) != 2 || graphqlErr.Errors[0].Locations[0].Line != 2 || len(graphqlErr.Errors[1].Path) != 3 {
+		t.Errorf("Expected both errors with locations and paths, got %+v", graphqlErr.Errors)
+	}
+	if !graphqlErr.HasData() || body == nil {
+		t.Error("Expected partial data to be returned with the error")
+	}
+	if graphqlErr.Error() != "GraphQL error: first (at b); second (at b.0.id)" {
+		t.Errorf("Unexpected message: %s", graphqlErr.Error())
+	}
+}
+
+func TestHeadAndBlockPinning(t *testing.T) {
+	var queries []GraphQLRequest
+	subgraph := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		queries = append(queries, req)
+
+		if strings.Contains(req.Query, "_meta") {
+			w.Write([]byte(`{"data":{"_meta":{"block":{"number":90,"hash":"0xabc"},"deployment":"Qm1","hasIndexingErrors":false}}}`))
+			return
+		}
+		w.Write([]byte(`{"data":{"paymentAllowances":[]}}`))
+	}))
+	defer subgraph.Close()
+
+	chain := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		json.NewDecoder(r.Body).Decode(&req)
+		if req.Method != "eth_blockNumber" {
+			t.Errorf("Unexpected RPC method %s", req.Method)
+		}
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":"0x64"}`, req.ID)
+	}))
+	defer chain.Close()
+
+	client := NewClient(Config{Endpoint: subgraph.URL, RPCURL: chain.URL})
+	defer client.Close()
+
+	pinned, head, err := client.AtHead(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to get head: %v", err)
+	}
+	if head.Number != 90 || head.ChainHead != 100 || head.Lag != 10 || head.Hash != "0xabc" {
+		t.Errorf("Unexpected head: %+v", head)
+	}
+	if block, ok := pinned.Block(); !ok || block != 90 {
+		t.Errorf("Expected client pinned to block 90, got %d", block)
+	}
+	if _, ok := client.Block(); ok {
+		t.Error("Expected the original client to stay unpinned")
+	}
+
+	if _, err := pinned.GetPaymentAllowancesPage(context.Background(), "0x0000000000000000000000000000000000000001", ListOptions{}); err != nil {
+		t.Fatalf("Failed to query pinned allowances: %v", err)
+	}
+	last := queries[len(queries)-1]
+	if !strings.Contains(last.Query, "block: {number: $block}") || last.Variables["block"].(float64) != 90 {
+		t.Errorf("Expected the query to be pinned to block 90, got %s with %v", last.Query, last.Variables)
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	subgraph := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		json.NewDecoder(r.Body).Decode(&req)
+
+		switch {
+		case strings.Contains(req.Query, "_meta"):
+			w.Write([]byte(`{"data":{"_meta":{"block":{"number":90,"hash":"0xabc"},"deployment":"Qm1","hasIndexingErrors":false}}}`))
+		case strings.Contains(req.Query, "paymentAccounts"):
+			if req.Variables["block"].(float64) != 90 {
+				t.Errorf("Expected accounts to be read at block 90, got %v", req.Variables["block"])
+			}
+			w.Write([]byte(`{"data":{"paymentAccounts":[{"id":"0x00000000000000000000000000000000000000aa","tokenBalances":[
+				{"balance":"100","token":{"id":"0x00000000000000000000000000000000000000b1","symbol":"USDT","decimals":"6"}},
+				{"balance":"5","token":{"id":"0x0000000000000000000000000000000000000000","symbol":"ETH","decimals":"18"}}]}]}}`))
+		default:
+			w.Write([]byte(`{"data":{"collectionAccounts":[]}}`))
+		}
+	}))
+	defer subgraph.Close()
+
+	var methods []string
+	chain := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var batch []struct {
+			ID     json.RawMessage   `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		if err := json.Unmarshal(body, &batch); err != nil {
+			// Single eth_blockNumber request made by Head
+			var req struct {
+				ID json.RawMessage `json:"id"`
+			}
+			json.Unmarshal(body, &req)
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":"0x64"}`, req.ID)
+			return
+		}
+
+		responses := make([]string, len(batch))
+		for i, req := range batch {
+			methods = append(methods, req.Method)
+			if string(req.Params[1]) != `"0x5a"` {
+				t.Errorf("Expected balance read at block 0x5a, got %s", req.Params[1])
+			}
+			result := `"0x5"`
+			if req.Method == "eth_call" {
+				result = `"0x` + strings.Repeat("0", 62) + `63"` // 99
+			}
+			responses[i] = fmt.Sprintf(`{"jsonrpc":"2.0","id":%s,"result":%s}`, req.ID, result)
+		}
+		fmt.Fprintf(w, "[%s]", strings.Join(responses, ","))
+	}))
+	defer chain.Close()
+
+	client := NewClient(Config{Endpoint: subgraph.URL, RPCURL: chain.URL})
+	defer client.Close()
+
+	report, err := client.Reconcile(context.Background(), ReconcileOptions{
+		PaymentAccounts: []string{"0x00000000000000000000000000000000000000AA"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to reconcile: %v", err)
+	}
+	if report.Block != 90 || report.Checked != 2 || len(methods) != 2 {
+		t.Fatalf("Unexpected report: %+v (methods %v)", report, methods)
+	}
+	if len(report.Mismatches) != 1 {
+		t.Fatalf("Expected 1 mismatch, got %+v", report.Mismatches)
+	}
+	mismatch := report.Mismatches[0]
+	if mismatch.Symbol != "USDT" || mismatch.Indexed.Int64() != 100 || mismatch.OnChain.Int64() != 99 {
+		t.Errorf("Unexpected mismatch: %+v", mismatch)
+	}
+}
+
+func TestWatchPaymentApprovalsResumesFromCheckpoint(t *testing.T) {
+	var mu sync.Mutex
+	records := []PaymentApproval{
+		{ID: "0xb", Timestamp: "100"},
+		{ID: "0xa", Timestamp: "100"},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		mu.Lock()
+		defer mu.Unlock()
+
+		timestamp, _ := strconv.Atoi(req.Variables["timestamp"].(string))
+		after, _ := req.Variables["after"].(string)
+		matches := []PaymentApproval{}
+		for _, record := range records {
+			ts, _ := strconv.Atoi(record.Timestamp)
+			if strings.Contains(req.Query, "GetPaymentApprovalsAt") && ts == timestamp && record.ID > after ||
+				strings.Contains(req.Query, "GetPaymentApprovalsAfter") && ts > timestamp {
+				matches = append(matches, record)
+			}
+		}
+		sort.Slice(matches, func(i, j int) bool {
+			if matches[i].Timestamp != matches[j].Timestamp {
+				return matches[i].Timestamp < matches[j].Timestamp
+			}
+			return matches[i].ID < matches[j].ID
+		})
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{"paymentApprovals": matches},
+		})
+	}))
+	defer server.Close()
+
+	store := NewFileCheckpointStore(filepath.Join(t.TempDir(), "checkpoints.json"))
+	account := "0x0000000000000000000000000000000000000001"
+	watch := func(want int) []string {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		client := NewClient(Config{Endpoint: server.URL, Checkpoints: store})
+		approvals, errs, err := client.WatchPaymentApprovals(ctx, account, 10*time.Millisecond)
+		if err != nil {
+			t.Fatalf("Failed to watch: %v", err)
+		}
+		var ids []string
+		for len(ids) < want {
+			select {
+			case approval := <-approvals:
+				ids = append(ids, approval.ID)
+			case err := <-errs:
+				t.Fatalf("Watch failed: %v", err)
+			case <-time.After(2 * time.Second):
+				t.Fatalf("Timed out after %v", ids)
+			}
+		}
+
+		// Wait for the watcher to save its checkpoint and stop
+		cancel()
+		for range approvals {
+		}
+		return ids
+	}
+
+	if ids := watch(2); strings.Join(ids, ",") != "0xa,0xb" {
+		t.Fatalf("Unexpected first approvals: %v", ids)
+	}
+
+	mu.Lock()
+	records = append(records, PaymentApproval{ID: "0xc", Timestamp: "100"}, PaymentApproval{ID: "0x1", Timestamp: "200"})
+	mu.Unlock()
+
+	if ids := watch(2); strings.Join(ids, ",") != "0xc,0x1" {
+		t.Fatalf("Expected only new approvals after restart, got %v", ids)
+	}
+}
+
+func TestWatchPaymentApprovalsOrdersTimestampTiesAcrossPages(t *testing.T) {
+	// A full first page whose last timestamp continues on the next page
+	var records []PaymentApproval
+	for i := 0; i < MaxPageSize-1; i++ {
+		records = append(records, PaymentApproval{ID: fmt.Sprintf("0x%04x", i), Timestamp: "100"})
+	}
+	records = append(records, PaymentApproval{ID: "0x9001", Timestamp: "200"}, PaymentApproval{ID: "0x9002", Timestamp: "200"})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		json.NewDecoder(r.Body).Decode(&req)
+
+		timestamp, _ := strconv.Atoi(req.Variables["timestamp"].(string))
+		after, _ := req.Variables["after"].(string)
+		first := int(req.Variables["first"].(float64))
+		atTimestamp := strings.Contains(req.Query, "GetPaymentApprovalsAt")
+		matches := []PaymentApproval{}
+		for _, record := range records {
+			ts, _ := strconv.Atoi(record.Timestamp)
+			if atTimestamp && ts == timestamp && record.ID > after || !atTimestamp && ts > timestamp {
+				matches = append(matches, record)
+			}
+		}
+		// Ties of the timestamp order come in reverse id order
+		sort.Slice(matches, func(i, j int) bool {
+			if matches[i].Timestamp != matches[j].Timestamp {
+				return matches[i].Timestamp < matches[j].Timestamp
+			}
+			return matches[i].ID < matches[j].ID == atTimestamp
+		})
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{"paymentApprovals": matches[:min(first, len(matches))]},
+		})
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client := NewClient(Config{Endpoint: server.URL})
+	approvals, errs, err := client.WatchPaymentApprovals(ctx, "0x0000000000000000000000000000000000000001", time.Hour)
+	if err != nil {
+		t.Fatalf("Failed to watch: %v", err)
+	}
+
+	var ids []string
+	for len(ids) < len(records) {
+		select {
+		case approval := <-approvals:
+			ids = append(ids, approval.ID)
+		case err := <-errs:
+			t.Fatalf("Watch failed: %v", err)
+		case <-time.After(2 * time.Second):
+			t.Fatalf("Timed out after %d approvals", len(ids))
+		}
+	}
+	for i, record := range records {
+		if ids[i] != record.ID {
+			t.Fatalf("Expected approval %d to be %s, got %s", i, record.ID, ids[i])
+		}
+	}
+}
+
+func TestDoWithFragments(t *testing.T) {
+	var received GraphQLRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&received)
+		w.Write([]byte(`{"data":{"tokens":[{"id":"0x1","symbol":"USDT","decimals":"6"}]}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Endpoint: server.URL})
+	tokenFields := Fragment{Name: "TokenFields", On: "Token", Fields: "id ...TokenMeta"}
+	tokenMeta := Fragment{Name: "TokenMeta", On: "Token", Fields: "symbol decimals"}
+	unused := Fragment{Name: "Unused", On: "Token", Fields: "name"}
+	query := WithFragments(`
+		query Tokens($first: Int!, $symbol: String) {
+			tokens(first: $first, where: {symbol: $symbol}) { ...TokenFields }
+		}
+	`, tokenFields, tokenMeta, unused)
+
+	type tokensData struct {
+		Tokens []TokenMetadata `json:"tokens"`
+	}
+	data, err := Do[tokensData](context.Background(), client, query, map[string]interface{}{"first": 10})
+	if err != nil {
+		t.Fatalf("Do failed: %v", err)
+	}
+	if len(data.Tokens) != 1 || data.Tokens[0].Symbol != "USDT" || data.Tokens[0].Decimals != 6 {
+		t.Errorf("Unexpected data: %+v", data)
+	}
+	if !strings.Contains(received.Query, "fragment TokenFields on Token") ||
+		!strings.Contains(received.Query, "fragment TokenMeta on Token") ||
+		strings.Contains(received.Query, "Unused") {
+		t.Errorf("Unexpected fragments in query: %s", received.Query)
+	}
+
+	tests := []struct {
+		name  string
+		query string
+		vars  map[string]interface{}
+		want  string
+	}{
+		{"missing required", query, nil, "missing required variable $first"},
+		{"undeclared value", query, map[string]interface{}{"first": 1, "skip": 0}, "variable $skip is not declared"},
+		{"undeclared reference", `query Q { tokens(first: $first) { id } }`, nil, "variable $first is not declared"},
+		{"undefined fragment", `query Q { tokens { ...TokenFields } }`, nil, "fragment TokenFields is not defined"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Do[tokensData](context.Background(), client, tt.query, tt.vars)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestGetTransactionInfosBatchesReceipts(t *testing.T) {
+	hashA := "0x" + strings.Repeat("a", 64)
+	hashB := "0x" + strings.Repeat("b", 64)
+	hashC := "0x" + strings.Repeat("c", 64)
+
+	subgraph := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"data":{"transactionInfos":[
+			{"id":%q,"blockNumber":"1","gasPrice":"100","timestamp":"10"},
+			{"id":%q,"blockNumber":"2","gasPrice":"100","timestamp":"20"}]}}`, hashA, hashB)
+	}))
+	defer subgraph.Close()
+
+	batches := 0
+	chain := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var batch []struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+			Params []string        `json:"params"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&batch); err != nil {
+			t.Fatalf("Expected a batch request: %v", err)
+		}
+		batches++
+
+		responses := make([]string, len(batch))
+		for i, req := range batch {
+			if req.Method != "eth_getTransactionReceipt" {
+				t.Errorf("Unexpected RPC method %s", req.Method)
+			}
+			if req.Params[0] == hashA {
+				responses[i] = fmt.Sprintf(`{"jsonrpc":"2.0","id":%s,"result":{"status":"0x1","gasUsed":"0x5208","effectiveGasPrice":"0x3c"}}`, req.ID)
+			} else {
+				responses[i] = fmt.Sprintf(`{"jsonrpc":"2.0","id":%s,"error":{"code":-32000,"message":"boom"}}`, req.ID)
+			}
+		}
+		fmt.Fprintf(w, "[%s]", strings.Join(responses, ","))
+	}))
+	defer chain.Close()
+
+	client := NewClient(Config{Endpoint: subgraph.URL, RPCURL: chain.URL})
+	defer client.Close()
+
+	results, err := client.GetTransactionInfos(context.Background(), []string{hashA, strings.ToUpper(hashB[2:]), hashC})
+	if err != nil {
+		t.Fatalf("Failed to get transaction infos: %v", err)
+	}
+	if batches != 1 || len(results) != 3 {
+		t.Fatalf("Expected 3 results from 1 batch, got %d results from %d batches", len(results), batches)
+	}
+
+	a := results[0]
+	if a.Err != nil || a.Info.GasUsed != "21000" || a.Info.Status != "1" || a.Info.EffectiveGasPrice != "60" || a.Info.TransactionFee != "1260000" {
+		t.Errorf("Unexpected enriched result: %+v (%v)", a.Info, a.Err)
+	}
+	if b := results[1]; b.Info == nil || b.Info.BlockNumber != "2" || b.Err == nil || !strings.Contains(b.Err.Error(), "boom") {
+		t.Errorf("Expected indexed data with the receipt error, got %+v (%v)", b.Info, b.Err)
+	}
+	if c := results[2]; c.Info != nil || c.Err == nil || !strings.Contains(c.Err.Error(), "not found") {
+		t.Errorf("Expected a not found error, got %+v (%v)", c.Info, c.Err)
+	}
+}
+
+func TestGetBalanceHistory(t *testing.T) {
+	subgraph := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		json.NewDecoder(r.Body).Decode(&req)
+
+		switch {
+		case strings.Contains(req.Query, "_meta"):
+			w.Write([]byte(`{"data":{"_meta":{"block":{"number":100,"hash":"0xabc"},"deployment":"Qm1","hasIndexingErrors":false}}}`))
+		case strings.Contains(req.Query, "paymentAccounts"):
+			// The account receives tokens at block 50 and holds block*10^6 afterwards
+			block := int(req.Variables["block"].(float64))
+			balances := ""
+			if block >= 50 {
+				balances = fmt.Sprintf(`{"balance":"%d000000","token":{"id":"0x00000000000000000000000000000000000000b1","symbol":"USDT","decimals":"6"}}`, block)
+			}
+			fmt.Fprintf(w, `{"data":{"paymentAccounts":[{"id":"0x00000000000000000000000000000000000000aa","tokenBalances":[%s]}]}}`, balances)
+		default:
+			w.Write([]byte(`{"data":{"collectionAccounts":[]}}`))
+		}
+	}))
+	defer subgraph.Close()
+
+	// Block n has timestamp 1000+10n
+	chain := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+			Params []interface{}   `json:"params"`
+		}
+		json.NewDecoder(r.Body).Decode(&req)
+		if req.Method == "eth_blockNumber" {
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":"0x64"}`, req.ID)
+			return
+		}
+		number, _ := strconv.ParseUint(strings.TrimPrefix(req.Params[0].(string), "0x"), 16, 64)
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":{"timestamp":"0x%x"}}`, req.ID, 1000+10*number)
+	}))
+	defer chain.Close()
+
+	client := NewClient(Config{Endpoint: subgraph.URL, RPCURL: chain.URL})
+	defer client.Close()
+
+	series, err := client.GetBalanceHistory(context.Background(), BalanceHistoryOptions{
+		PaymentAccounts: []string{"0x00000000000000000000000000000000000000aa"},
+		From:            time.Unix(1005, 0),
+		To:              time.Unix(2500, 0),
+		Interval:        300 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("Failed to get balance history: %v", err)
+	}
+	if len(series) != 1 || series[0].AccountType != PaymentAccountType || series[0].Token.Symbol != "USDT" {
+		t.Fatalf("Unexpected series: %+v", series)
+	}
+
+	var got []string
+	for _, point := range series[0].Points {
+		got = append(got, fmt.Sprintf("%d:%s", point.Block, point.Balance))
+	}
+	// The bucket at 2205 is after the indexed head and left out
+	if strings.Join(got, ",") != "0:0,30:0,60:60,90:90" {
+		t.Errorf("Unexpected points: %v", got)
+	}
+
+	var buf strings.Builder
+	if err := WriteBalanceHistoryCSV(&buf, series); err != nil {
+		t.Fatalf("Failed to write CSV: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 5 || lines[4] != "1970-01-01T00:31:45Z,90,payment,0x00000000000000000000000000000000000000aa,0x00000000000000000000000000000000000000b1,USDT,6,90000000,90" {
+		t.Errorf("Unexpected CSV:\n%s", buf.String())
+	}
+}
@@ -0,0 +1,155 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	// operationPattern matches the variable declarations of an operation
+	operationPattern = regexp.MustCompile(`(?s)^\s*(?:query|mutation|subscription)\b[^({]*\(([^)]*)\)`)
+	// declarationPattern matches one variable declaration, e.g. "$ids: [ID!]!" or "$first: Int = 10"
+	declarationPattern = regexp.MustCompile(`\$(\w+)\s*:\s*(\[[^\]]*\]!?|\w+!?)\s*(=)?`)
+	// variablePattern matches a variable reference
+	variablePattern = regexp.MustCompile(`\$(\w+)`)
+	// spreadPattern matches a named fragment spread, but not an inline "... on Type"
+	spreadPattern = regexp.MustCompile(`\.\.\.\s*(\w+)`)
+	// fragmentPattern matches a fragment definition
+	fragmentPattern = regexp.MustCompile(`\bfragment\s+(\w+)\s+on\b`)
+)
+
+// Fragment is a reusable GraphQL fragment
+type Fragment struct {
+	Name   string // Fragment name used in spreads, e.g. "TokenFields"
+	On     string // Type the fragment applies to, e.g. "Token"
+	Fields string // Selection set without the surrounding braces
+}
+
+// String returns the fragment definition
+func (f Fragment) String() string {
+	return fmt.Sprintf("fragment %s on %s {\n\t%s\n}", f.Name, f.On, strings.TrimSpace(f.Fields))
+}
+
+// WithFragments appends the definitions of the fragments spread in query, including
+// fragments spread by other fragments. Fragments that are not used are left out.
+func WithFragments(query string, fragments ...Fragment) string {
+	byName := make(map[string]Fragment, len(fragments))
+	for _, fragment := range fragments {
+		byName[fragment.Name] = fragment
+	}
+
+	defined := make(map[string]bool)
+	for _, match := range fragmentPattern.FindAllStringSubmatch(query, -1) {
+		defined[match[1]] = true
+	}
+
+	var definitions []string
+	pending := []string{query}
+	for len(pending) > 0 {
+		text := pending[0]
+		pending = pending[1:]
+		for _, match := range spreadPattern.FindAllStringSubmatch(text, -1) {
+			fragment, ok := byName[match[1]]
+			if !ok || defined[fragment.Name] {
+				continue
+			}
+			defined[fragment.Name] = true
+			definitions = append(definitions, fragment.String())
+			pending = append(pending, fragment.Fields)
+		}
+	}
+
+	if len(definitions) == 0 {
+		return query
+	}
+	return strings.TrimRight(query, " \t\n") + "\n\n" + strings.Join(definitions, "\n\n") + "\n"
+}
+
+// Do validates query against vars, executes it and decodes the data of the
+// response into T. When the response holds GraphQL errors along with partial
+// data, the decoded partial data is returned together with the *GraphQLError.
+func Do[T any](ctx context.Context, client *Client, query string, vars map[string]interface{}) (T, error) {
+	var result T
+	if err := validateQuery(query, vars); err != nil {
+		return result, err
+	}
+
+	body, queryErr := client.Query(ctx, query, vars)
+	if body == nil {
+		return result, queryErr
+	}
+
+	var response GraphQLResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return result, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+	data := bytes.TrimSpace(response.Data)
+	if len(data) > 0 && !bytes.Equal(data, []byte("null")) {
+		if err := json.Unmarshal(data, &result); err != nil {
+			return result, fmt.Errorf("failed to unmarshal response data: %w", err)
+		}
+	}
+
+	return result, queryErr
+}
+
+// validateQuery checks that every variable used by query is declared, that every
+// required variable is set, that vars holds no undeclared variables and that every
+// fragment spread has a definition
+func validateQuery(query string, vars map[string]interface{}) error {
+	declared := make(map[string]bool)
+	var problems []string
+
+	header := ""
+	if match := operationPattern.FindStringSubmatch(query); match != nil {
+		header = match[0]
+		for _, declaration := range declarationPattern.FindAllStringSubmatch(match[1], -1) {
+			name, typ, hasDefault := declaration[1], declaration[2], declaration[3] != ""
+			declared[name] = true
+			if value, ok := vars[name]; strings.HasSuffix(typ, "!") && !hasDefault && (!ok || value == nil) {
+				problems = append(problems, fmt.Sprintf("missing required variable $%s", name))
+			}
+		}
+	}
+
+	used := make(map[string]bool)
+	for _, match := range variablePattern.FindAllStringSubmatch(query[len(header):], -1) {
+		name := match[1]
+		if !declared[name] && !used[name] {
+			problems = append(problems, fmt.Sprintf("variable $%s is not declared", name))
+		}
+		used[name] = true
+	}
+
+	var extra []string
+	for name := range vars {
+		if !declared[name] && !used[name] {
+			extra = append(extra, name)
+		}
+	}
+	sort.Strings(extra)
+	for _, name := range extra {
+		problems = append(problems, fmt.Sprintf("variable $%s is not declared", name))
+	}
+
+	defined := make(map[string]bool)
+	for _, match := range fragmentPattern.FindAllStringSubmatch(query, -1) {
+		defined[match[1]] = true
+	}
+	for _, match := range spreadPattern.FindAllStringSubmatch(query, -1) {
+		if name := match[1]; name != "on" && !defined[name] {
+			problems = append(problems, fmt.Sprintf("fragment %s is not defined", name))
+			defined[name] = true
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid query: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// transientMessages are fragments of GraphQL error messages worth retrying,
+// e.g. when a query is pinned to a block the subgraph has not indexed yet
+var transientMessages = []string{
+	"indexing behind",
+	"has only indexed up to",
+	"not yet available",
+	"too many requests",
+	"rate limit",
+	"timeout",
+}
+
+// GraphQLError is returned when the response holds GraphQL errors. It keeps
+// every error and any partial data returned alongside them.
+type GraphQLError struct {
+	Errors []ErrorDetail
+	Data   json.RawMessage // Partial data, nil when the response had none
+}
+
+// Error returns the messages of all errors
+func (e *GraphQLError) Error() string {
+	messages := make([]string, len(e.Errors))
+	for i, detail := range e.Errors {
+		messages[i] = detail.Message
+		if len(detail.Path) > 0 {
+			path := make([]string, len(detail.Path))
+			for j, segment := range detail.Path {
+				path[j] = fmt.Sprint(segment)
+			}
+			messages[i] += fmt.Sprintf(" (at %s)", strings.Join(path, "."))
+		}
+	}
+	return "GraphQL error: " + strings.Join(messages, "; ")
+}
+
+// HasData reports whether the response carried partial data
+func (e *GraphQLError) HasData() bool {
+	trimmed := bytes.TrimSpace(e.Data)
+	return len(trimmed) > 0 && !bytes.Equal(trimmed, []byte("null"))
+}
+
+// Temporary reports whether any of the errors is transient
+func (e *GraphQLError) Temporary() bool {
+	for _, detail := range e.Errors {
+		message := strings.ToLower(detail.Message)
+		for _, fragment := range transientMessages {
+			if strings.Contains(message, fragment) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// HTTPError is returned when the endpoint answers with a non-200 status
+type HTTPError struct {
+	StatusCode int
+	Body       string        // Start of the response body
+	RetryAfter time.Duration // Parsed Retry-After header, zero when absent
+}
+
+// Error returns the status code and body
+func (e *HTTPError) Error() string {
+	if e.Body == "" {
+		return fmt.Sprintf("unexpected status code: %d", e.StatusCode)
+	}
+	return fmt.Sprintf("unexpected status code: %d: %s", e.StatusCode, e.Body)
+}
+
+// Temporary reports whether the request may succeed when retried
+func (e *HTTPError) Temporary() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= 500
+}
+
+// IsRetryable reports whether err is a transient failure: a 429 or 5xx
+// response, a transient GraphQL error or a network error
+func IsRetryable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var graphqlErr *GraphQLError
+	if errors.As(err, &graphqlErr) {
+		return graphqlErr.Temporary()
+	}
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.Temporary()
+	}
+	var requestErr *requestError
+	return errors.As(err, &requestErr)
+}
+
+// requestError wraps failures to reach the endpoint
+type requestError struct {
+	err error
+}
+
+func (e *requestError) Error() string {
+	return fmt.Sprintf("failed to execute request: %v", e.err)
+}
+
+func (e *requestError) Unwrap() error {
+	return e.err
+}
@@ -0,0 +1,362 @@
+package graphqltest
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// maxFirst is the largest page size a subgraph accepts
+const maxFirst = 1000
+
+// filterSuffixes are the supported where operators, longest first
+var filterSuffixes = []string{"_not_in", "_in", "_not", "_gte", "_lte", "_gt", "_lt"}
+
+// field is a top-level field of a query with its resolved arguments
+type field struct {
+	alias string
+	name  string
+	args  map[string]interface{}
+}
+
+// key returns the response key of the field
+func (f field) key() string {
+	if f.alias != "" {
+		return f.alias
+	}
+	return f.name
+}
+
+// parser reads the subset of GraphQL used by queries: one operation with
+// top-level fields whose arguments are values or variables
+type parser struct {
+	src  []rune
+	pos  int
+	vars map[string]interface{}
+}
+
+// parseQuery returns the top-level fields of the first operation in query
+func parseQuery(query string, vars map[string]interface{}) ([]field, error) {
+	p := &parser{src: []rune(query), vars: vars}
+
+	// Skip the operation keyword, name and variable definitions
+	p.skipSpace()
+	if p.peek() != '{' {
+		p.ident()
+		p.skipSpace()
+		p.ident()
+		p.skipSpace()
+		if p.peek() == '(' {
+			if err := p.skipBalanced('(', ')'); err != nil {
+				return nil, err
+			}
+		}
+		p.skipSpace()
+	}
+	if !p.consume('{') {
+		return nil, p.errorf("expected selection set")
+	}
+
+	var fields []field
+	for {
+		p.skipSpace()
+		if p.consume('}') {
+			return fields, nil
+		}
+		if p.pos >= len(p.src) {
+			return nil, p.errorf("unterminated selection set")
+		}
+
+		f := field{name: p.ident(), args: map[string]interface{}{}}
+		if f.name == "" {
+			return nil, p.errorf("expected field name")
+		}
+		p.skipSpace()
+		if p.consume(':') {
+			p.skipSpace()
+			f.alias, f.name = f.name, p.ident()
+			p.skipSpace()
+		}
+		if p.consume('(') {
+			args, err := p.fields(')')
+			if err != nil {
+				return nil, err
+			}
+			f.args = args
+			p.skipSpace()
+		}
+		if p.peek() == '{' {
+			if err := p.skipBalanced('{', '}'); err != nil {
+				return nil, err
+			}
+		}
+		fields = append(fields, f)
+	}
+}
+
+// fields reads "name: value" pairs up to the closing rune
+func (p *parser) fields(closing rune) (map[string]interface{}, error) {
+	result := map[string]interface{}{}
+	for {
+		p.skipSpace()
+		if p.consume(closing) {
+			return result, nil
+		}
+		name := p.ident()
+		if name == "" {
+			return nil, p.errorf("expected argument name")
+		}
+		p.skipSpace()
+		if !p.consume(':') {
+			return nil, p.errorf("expected ':' after %s", name)
+		}
+		value, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		result[name] = value
+	}
+}
+
+// value reads a literal, list, object or variable
+func (p *parser) value() (interface{}, error) {
+	p.skipSpace()
+	switch r := p.peek(); {
+	case r == '$':
+		p.pos++
+		name := p.ident()
+		return p.vars[name], nil
+	case r == '{':
+		p.pos++
+		return p.fields('}')
+	case r == '[':
+		p.pos++
+		list := []interface{}{}
+		for {
+			p.skipSpace()
+			if p.consume(']') {
+				return list, nil
+			}
+			value, err := p.value()
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, value)
+		}
+	case r == '"':
+		start := p.pos
+		for p.pos++; p.pos < len(p.src) && p.src[p.pos] != '"'; p.pos++ {
+			if p.src[p.pos] == '\\' {
+				p.pos++
+			}
+		}
+		p.pos++
+		s, err := strconv.Unquote(string(p.src[start:min(p.pos, len(p.src))]))
+		if err != nil {
+			return nil, p.errorf("invalid string")
+		}
+		return s, nil
+	case r == '-' || unicode.IsDigit(r):
+		start := p.pos
+		for p.pos++; p.pos < len(p.src) && strings.ContainsRune("0123456789.eE+-", p.src[p.pos]); p.pos++ {
+		}
+		return json.Number(string(p.src[start:p.pos])), nil
+	default:
+		switch name := p.ident(); name {
+		case "":
+			return nil, p.errorf("expected value")
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		case "null":
+			return nil, nil
+		default:
+			return name, nil // Enum value
+		}
+	}
+}
+
+func (p *parser) ident() string {
+	start := p.pos
+	for p.pos < len(p.src) && (p.src[p.pos] == '_' || unicode.IsLetter(p.src[p.pos]) || unicode.IsDigit(p.src[p.pos])) {
+		p.pos++
+	}
+	return string(p.src[start:p.pos])
+}
+
+// skipSpace skips whitespace, commas and comments
+func (p *parser) skipSpace() {
+	for p.pos < len(p.src) {
+		switch r := p.src[p.pos]; {
+		case r == '#':
+			for p.pos < len(p.src) && p.src[p.pos] != '\n' {
+				p.pos++
+			}
+		case r == ',' || unicode.IsSpace(r):
+			p.pos++
+		default:
+			return
+		}
+	}
+}
+
+// skipBalanced skips from an opening rune to its matching closing rune
+func (p *parser) skipBalanced(open, close rune) error {
+	depth := 0
+	for ; p.pos < len(p.src); p.pos++ {
+		switch p.src[p.pos] {
+		case open:
+			depth++
+		case close:
+			depth--
+			if depth == 0 {
+				p.pos++
+				return nil
+			}
+		}
+	}
+	return p.errorf("unbalanced %c", open)
+}
+
+func (p *parser) peek() rune {
+	if p.pos >= len(p.src) {
+		return 0
+	}
+	return p.src[p.pos]
+}
+
+func (p *parser) consume(r rune) bool {
+	if p.peek() != r {
+		return false
+	}
+	p.pos++
+	return true
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("Syntax Error at offset %d: %s", p.pos, fmt.Sprintf(format, args...))
+}
+
+// list applies where, orderBy, orderDirection, skip and first to a collection
+func list(collection []interface{}, args map[string]interface{}) ([]interface{}, error) {
+	first, err := intArg(args, "first", 100)
+	if err != nil {
+		return nil, err
+	}
+	if first < 0 || first > maxFirst {
+		return nil, fmt.Errorf("The `first` argument must be between 0 and %d, but is %d", maxFirst, first)
+	}
+	skip, err := intArg(args, "skip", 0)
+	if err != nil {
+		return nil, err
+	}
+	where, _ := args["where"].(map[string]interface{})
+
+	matches := []interface{}{}
+	for _, entity := range collection {
+		object, _ := entity.(map[string]interface{})
+		ok, err := matchesWhere(object, where)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			matches = append(matches, entity)
+		}
+	}
+
+	orderBy, _ := args["orderBy"].(string)
+	if orderBy == "" {
+		orderBy = "id"
+	}
+	descending := args["orderDirection"] == "desc"
+	sort.SliceStable(matches, func(i, j int) bool {
+		a, _ := matches[i].(map[string]interface{})
+		b, _ := matches[j].(map[string]interface{})
+		if order := compare(a[orderBy], b[orderBy]); order != 0 {
+			return order < 0 != descending
+		}
+		return compare(a["id"], b["id"]) < 0
+	})
+
+	if skip >= len(matches) {
+		return []interface{}{}, nil
+	}
+	matches = matches[skip:]
+	return matches[:min(first, len(matches))], nil
+}
+
+// matchesWhere reports whether entity passes every filter
+func matchesWhere(entity map[string]interface{}, where map[string]interface{}) (bool, error) {
+	for key, want := range where {
+		name, op := key, ""
+		for _, suffix := range filterSuffixes {
+			if strings.HasSuffix(key, suffix) {
+				name, op = strings.TrimSuffix(key, suffix), suffix
+				break
+			}
+		}
+		if _, nested := want.(map[string]interface{}); nested {
+			return false, fmt.Errorf("unsupported filter %s", key)
+		}
+
+		got, present := entity[name]
+		var ok bool
+		switch op {
+		case "":
+			ok = present && compare(got, want) == 0
+		case "_not":
+			ok = !present || compare(got, want) != 0
+		case "_gt":
+			ok = present && compare(got, want) > 0
+		case "_gte":
+			ok = present && compare(got, want) >= 0
+		case "_lt":
+			ok = present && compare(got, want) < 0
+		case "_lte":
+			ok = present && compare(got, want) <= 0
+		case "_in", "_not_in":
+			values, _ := want.([]interface{})
+			found := false
+			for _, value := range values {
+				if present && compare(got, value) == 0 {
+					found = true
+					break
+				}
+			}
+			ok = found == (op == "_in")
+		}
+		if !ok {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// compare orders two scalar values, numerically when both are integers and
+// otherwise as case-insensitive strings
+func compare(a, b interface{}) int {
+	as, bs := strings.ToLower(fmt.Sprint(a)), strings.ToLower(fmt.Sprint(b))
+	if an, ok := new(big.Int).SetString(as, 10); ok {
+		if bn, ok := new(big.Int).SetString(bs, 10); ok {
+			return an.Cmp(bn)
+		}
+	}
+	return strings.Compare(as, bs)
+}
+
+func intArg(args map[string]interface{}, name string, fallback int) (int, error) {
+	value, ok := args[name]
+	if !ok || value == nil {
+		return fallback, nil
+	}
+	n, err := strconv.Atoi(fmt.Sprint(value))
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s argument: %v", name, value)
+	}
+	return n, nil
+}
@@ -0,0 +1,250 @@
+// Package graphqltest provides an in-process fake subgraph for testing code that
+// uses graphql.Client without network access.
+package graphqltest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vikkkko/safe-core-sdk-golang/graphql"
+)
+
+// Fixtures maps a collection name used in queries, e.g. "paymentAllowances", to a
+// list of entity objects. Non-list values such as "_meta" are returned as they are.
+// Entities may carry fields the client does not select, e.g. "paymentAccount" or
+// "account", so that queries can filter on them.
+type Fixtures map[string]interface{}
+
+// LoadFixtures reads fixtures from a JSON file
+func LoadFixtures(path string) (Fixtures, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open fixtures: %w", err)
+	}
+	defer file.Close()
+	return ParseFixtures(file)
+}
+
+// ParseFixtures decodes fixtures from JSON
+func ParseFixtures(r io.Reader) (Fixtures, error) {
+	decoder := json.NewDecoder(r)
+	decoder.UseNumber()
+
+	var fixtures Fixtures
+	if err := decoder.Decode(&fixtures); err != nil {
+		return nil, fmt.Errorf("failed to parse fixtures: %w", err)
+	}
+	return fixtures, nil
+}
+
+// Fault is a failure injected into the responses of the server
+type Fault struct {
+	Match       string                // Only fail queries containing Match, e.g. "paymentApprovals" (empty for all)
+	Times       int                   // Number of requests to fail (default: 1)
+	Status      int                   // HTTP status to answer with, e.g. 429 or 503
+	Errors      []graphql.ErrorDetail // GraphQL errors to answer with when Status is 0
+	PartialData bool                  // Return the query data alongside Errors
+	RetryAfter  time.Duration         // Retry-After header sent with Status
+}
+
+// Server is a fake subgraph answering the queries issued by graphql.Client from
+// fixture data. Filters (where), ordering, first/skip and id_gt pagination are
+// applied like a subgraph does; block arguments are accepted but ignored.
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	data     Fixtures
+	faults   []*Fault
+	requests []graphql.GraphQLRequest
+}
+
+// NewServer starts a fake subgraph serving fixtures. Close it when done.
+func NewServer(fixtures Fixtures) *Server {
+	s := &Server{data: make(Fixtures)}
+	for name, value := range fixtures {
+		s.Set(name, value)
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// Client returns a client for the server whose retries do not wait
+func (s *Server) Client() *graphql.Client {
+	return graphql.NewClient(graphql.Config{
+		Endpoint: s.URL,
+		Retry: graphql.RetryConfig{
+			BaseDelay: time.Millisecond,
+			MaxDelay:  time.Millisecond,
+		},
+	})
+}
+
+// Set replaces the fixture value of a collection. value may be any JSON
+// serializable value, e.g. a slice of maps or structs.
+func (s *Server) Set(name string, value interface{}) {
+	normalized, err := normalize(value)
+	if err != nil {
+		panic(fmt.Sprintf("graphqltest: invalid fixture %s: %v", name, err))
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.data[name] = normalized
+}
+
+// Add appends entities to a collection
+func (s *Server) Add(name string, entities ...interface{}) {
+	normalized, err := normalize(entities)
+	if err != nil {
+		panic(fmt.Sprintf("graphqltest: invalid fixture %s: %v", name, err))
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	existing, _ := s.data[name].([]interface{})
+	s.data[name] = append(existing, normalized.([]interface{})...)
+}
+
+// Fail injects a fault into the next matching requests
+func (s *Server) Fail(fault Fault) {
+	if fault.Times == 0 {
+		fault.Times = 1
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &fault)
+}
+
+// Requests returns the requests received so far
+func (s *Server) Requests() []graphql.GraphQLRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]graphql.GraphQLRequest(nil), s.requests...)
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	var req graphql.GraphQLRequest
+	decoder := json.NewDecoder(r.Body)
+	decoder.UseNumber()
+	if err := decoder.Decode(&req); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = append(s.requests, req)
+
+	fault := s.takeFault(req.Query)
+	if fault != nil && fault.Status != 0 {
+		if fault.RetryAfter > 0 {
+			w.Header().Set("Retry-After", fmt.Sprint(int(fault.RetryAfter.Seconds())))
+		}
+		http.Error(w, http.StatusText(fault.Status), fault.Status)
+		return
+	}
+
+	response := map[string]interface{}{}
+	data, err := s.execute(req.Query, req.Variables)
+	if err != nil {
+		response["errors"] = []graphql.ErrorDetail{{Message: err.Error()}}
+		response["data"] = nil
+	} else {
+		response["data"] = data
+	}
+	if fault != nil {
+		response["errors"] = fault.Errors
+		if !fault.PartialData {
+			response["data"] = nil
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// takeFault returns the first fault matching query and counts it down
+func (s *Server) takeFault(query string) *Fault {
+	for i, fault := range s.faults {
+		if fault.Match != "" && !strings.Contains(query, fault.Match) {
+			continue
+		}
+		fault.Times--
+		if fault.Times <= 0 {
+			s.faults = append(s.faults[:i], s.faults[i+1:]...)
+		}
+		return fault
+	}
+	return nil
+}
+
+// execute resolves every top-level field of query
+func (s *Server) execute(query string, variables map[string]interface{}) (map[string]interface{}, error) {
+	fields, err := parseQuery(query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make(map[string]interface{}, len(fields))
+	for _, field := range fields {
+		result, err := s.resolve(field)
+		if err != nil {
+			return nil, err
+		}
+		data[field.key()] = result
+	}
+	return data, nil
+}
+
+// resolve answers one top-level field from the fixtures
+func (s *Server) resolve(field field) (interface{}, error) {
+	value, ok := s.data[field.name]
+	if !ok {
+		// Single entity lookup, e.g. transactionInfo(id: ...) on transactionInfos
+		if collection, ok := s.data[field.name+"s"].([]interface{}); ok {
+			id := field.args["id"]
+			for _, entity := range collection {
+				if object, _ := entity.(map[string]interface{}); compare(object["id"], id) == 0 {
+					return entity, nil
+				}
+			}
+			return nil, nil
+		}
+		if strings.HasSuffix(field.name, "s") {
+			return []interface{}{}, nil
+		}
+		return nil, fmt.Errorf("Type `Query` has no field `%s`", field.name)
+	}
+
+	collection, ok := value.([]interface{})
+	if !ok {
+		return value, nil
+	}
+	return list(collection, field.args)
+}
+
+// normalize converts value into its generic JSON form
+func normalize(value interface{}) (interface{}, error) {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.UseNumber()
+	var normalized interface{}
+	if err := decoder.Decode(&normalized); err != nil {
+		return nil, err
+	}
+	return normalized, nil
+}
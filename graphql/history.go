@@ -0,0 +1,272 @@
+package graphql
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/vikkkko/safe-core-sdk-golang/protocol/utils"
+)
+
+// MaxBalanceBuckets limits the number of snapshots of one balance history query
+const MaxBalanceBuckets = 1000
+
+// Account types of a BalanceSeries
+const (
+	PaymentAccountType    = "payment"
+	CollectionAccountType = "collection"
+)
+
+// BalanceHistoryOptions selects the accounts and time range of a balance history
+type BalanceHistoryOptions struct {
+	PaymentAccounts    []string
+	CollectionAccounts []string
+	From               time.Time
+	To                 time.Time
+	Interval           time.Duration // Bucket size (default: 24h)
+}
+
+// BalancePoint is the balance of a token at the end of a bucket
+type BalancePoint struct {
+	Time    time.Time `json:"time"`    // End of the bucket
+	Block   uint64    `json:"block"`   // Last block at or before Time
+	Raw     *big.Int  `json:"raw"`     // Balance in the smallest token unit
+	Balance string    `json:"balance"` // Balance normalised with the token decimals
+}
+
+// BalanceSeries is the balance history of one token held by one account
+type BalanceSeries struct {
+	Account     string         `json:"account"`
+	AccountType string         `json:"accountType"` // PaymentAccountType or CollectionAccountType
+	Token       TokenMetadata  `json:"token"`
+	Points      []BalancePoint `json:"points"`
+}
+
+// balanceKey identifies one BalanceSeries
+type balanceKey struct {
+	accountType string
+	account     string
+	token       string
+}
+
+// GetBalanceHistory returns time-bucketed balance snapshots of the given accounts.
+// A snapshot is taken at From, From+Interval, ... up to To by querying the subgraph
+// at the last block before each bucket end (time-travel queries on the token
+// balance entities). Buckets after the latest indexed block are left out, and
+// tokens an account did not hold yet are reported as zero. RPCURL must be
+// configured to map times to blocks.
+func (c *Client) GetBalanceHistory(ctx context.Context, opts BalanceHistoryOptions) ([]BalanceSeries, error) {
+	if opts.Interval == 0 {
+		opts.Interval = 24 * time.Hour
+	}
+	if opts.Interval < 0 {
+		return nil, fmt.Errorf("interval must be positive, got %s", opts.Interval)
+	}
+	if opts.To.Before(opts.From) {
+		return nil, fmt.Errorf("to (%s) is before from (%s)", opts.To, opts.From)
+	}
+	if buckets := opts.To.Sub(opts.From)/opts.Interval + 1; buckets > MaxBalanceBuckets {
+		return nil, fmt.Errorf("too many buckets: %d (max %d)", buckets, MaxBalanceBuckets)
+	}
+
+	rpcClient, err := c.getRPCClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to RPC: %w", err)
+	}
+	head, err := c.Head(ctx)
+	if err != nil {
+		return nil, err
+	}
+	blocks := &blockTimes{client: rpcClient, times: make(map[uint64]uint64)}
+	headTime, err := blocks.timestamp(ctx, head.Number)
+	if err != nil {
+		return nil, err
+	}
+
+	var points []BalancePoint
+	var snapshots []map[balanceKey]*big.Int
+	tokens := make(map[balanceKey]TokenMetadata)
+	var lowest uint64
+
+	for t := opts.From; !t.After(opts.To); t = t.Add(opts.Interval) {
+		if t.Unix() < 0 {
+			continue
+		}
+		if uint64(t.Unix()) > headTime {
+			// Not indexed yet
+			break
+		}
+		block, ok, err := blocks.lastBefore(ctx, uint64(t.Unix()), lowest, head.Number)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			// Bucket ends before the first block
+			continue
+		}
+		lowest = block
+
+		snapshot, err := c.AtBlock(block).balanceSnapshot(ctx, opts, tokens)
+		if err != nil {
+			return nil, err
+		}
+		points = append(points, BalancePoint{Time: t, Block: block})
+		snapshots = append(snapshots, snapshot)
+	}
+
+	result := make([]BalanceSeries, 0, len(tokens))
+	for key, token := range tokens {
+		series := BalanceSeries{
+			Account:     key.account,
+			AccountType: key.accountType,
+			Token:       token,
+			Points:      make([]BalancePoint, len(points)),
+		}
+		for i, point := range points {
+			raw, ok := snapshots[i][key]
+			if !ok {
+				raw = new(big.Int)
+			}
+			point.Raw = raw
+			point.Balance = utils.FormatTokenAmount(raw, uint8(token.Decimals))
+			series.Points[i] = point
+		}
+		result = append(result, series)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Account != result[j].Account {
+			return result[i].Account < result[j].Account
+		}
+		return result[i].Token.ID < result[j].Token.ID
+	})
+
+	return result, nil
+}
+
+// balanceSnapshot reads the token balances of the accounts at the block the client
+// is pinned to and records the metadata of every token in tokens
+func (c *Client) balanceSnapshot(ctx context.Context, opts BalanceHistoryOptions, tokens map[balanceKey]TokenMetadata) (map[balanceKey]*big.Int, error) {
+	snapshot := make(map[balanceKey]*big.Int)
+	add := func(accountType, account string, balances []TokenBalance) {
+		for _, balance := range balances {
+			key := balanceKey{accountType, account, balance.Token.ID}
+			tokens[key] = balance.Token
+			raw := new(big.Int)
+			if balance.Balance.Int != nil {
+				raw.Set(balance.Balance.Int)
+			}
+			snapshot[key] = raw
+		}
+	}
+
+	payment, err := c.GetPaymentAccounts(ctx, opts.PaymentAccounts)
+	if err != nil {
+		return nil, err
+	}
+	for _, account := range payment {
+		add(PaymentAccountType, account.ID, account.TokenBalances)
+	}
+
+	collection, err := c.GetCollectionAccounts(ctx, opts.CollectionAccounts)
+	if err != nil {
+		return nil, err
+	}
+	for _, account := range collection {
+		add(CollectionAccountType, account.ID, account.TokenBalances)
+	}
+
+	return snapshot, nil
+}
+
+// WriteBalanceHistoryCSV writes one row per series and point, with a header row
+func WriteBalanceHistoryCSV(w io.Writer, series []BalanceSeries) error {
+	writer := csv.NewWriter(w)
+	header := []string{"time", "block", "account_type", "account", "token", "symbol", "decimals", "raw_balance", "balance"}
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write CSV: %w", err)
+	}
+
+	for _, s := range series {
+		for _, point := range s.Points {
+			row := []string{
+				point.Time.UTC().Format(time.RFC3339),
+				strconv.FormatUint(point.Block, 10),
+				s.AccountType,
+				s.Account,
+				s.Token.ID,
+				s.Token.Symbol,
+				strconv.Itoa(int(s.Token.Decimals)),
+				point.Raw.String(),
+				point.Balance,
+			}
+			if err := writer.Write(row); err != nil {
+				return fmt.Errorf("failed to write CSV: %w", err)
+			}
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("failed to write CSV: %w", err)
+	}
+	return nil
+}
+
+// blockTimes looks up block timestamps, caching every block it has read
+type blockTimes struct {
+	client *rpc.Client
+	times  map[uint64]uint64
+}
+
+// timestamp returns the timestamp of block number
+func (b *blockTimes) timestamp(ctx context.Context, number uint64) (uint64, error) {
+	if ts, ok := b.times[number]; ok {
+		return ts, nil
+	}
+
+	var header *struct {
+		Timestamp hexutil.Uint64 `json:"timestamp"`
+	}
+	if err := b.client.CallContext(ctx, &header, "eth_getBlockByNumber", hexutil.EncodeUint64(number), false); err != nil {
+		return 0, fmt.Errorf("failed to get block %d: %w", number, err)
+	}
+	if header == nil {
+		return 0, fmt.Errorf("block %d not found", number)
+	}
+
+	b.times[number] = uint64(header.Timestamp)
+	return uint64(header.Timestamp), nil
+}
+
+// lastBefore returns the last block in [lo, hi] with a timestamp at or before ts,
+// or false when the timestamp of lo is already after ts
+func (b *blockTimes) lastBefore(ctx context.Context, ts, lo, hi uint64) (uint64, bool, error) {
+	first, err := b.timestamp(ctx, lo)
+	if err != nil {
+		return 0, false, err
+	}
+	if first > ts {
+		return 0, false, nil
+	}
+
+	for lo < hi {
+		mid := lo + (hi-lo+1)/2
+		midTime, err := b.timestamp(ctx, mid)
+		if err != nil {
+			return 0, false, err
+		}
+		if midTime <= ts {
+			lo = mid
+		} else {
+			hi = mid - 1
+		}
+	}
+	return lo, true, nil
+}
@@ -0,0 +1,119 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+)
+
+// Head describes how far a subgraph has indexed
+type Head struct {
+	Number            uint64 `json:"number"` // Latest indexed block
+	Hash              string `json:"hash"`
+	Deployment        string `json:"deployment"`
+	HasIndexingErrors bool   `json:"hasIndexingErrors"`
+	ChainHead         uint64 `json:"chainHead,omitempty"` // Latest chain block, 0 when RPCURL is not configured
+	Lag               uint64 `json:"lag"`                 // Blocks the subgraph is behind ChainHead
+}
+
+// MetaResponse represents the GraphQL response for the _meta query
+type MetaResponse struct {
+	Data MetaData `json:"data"`
+}
+
+// MetaData is the data of the _meta query
+type MetaData struct {
+	Meta *struct {
+		Block struct {
+			Number uint64 `json:"number"`
+			Hash   string `json:"hash"`
+		} `json:"block"`
+		Deployment        string `json:"deployment"`
+		HasIndexingErrors bool   `json:"hasIndexingErrors"`
+	} `json:"_meta"`
+}
+
+// Head returns the latest block indexed by the subgraph. When RPCURL is
+// configured it also reads the chain head and reports how far the subgraph lags.
+func (c *Client) Head(ctx context.Context) (*Head, error) {
+	query := `
+		query GetMeta {
+			_meta {
+				block {
+					number
+					hash
+				}
+				deployment
+				hasIndexingErrors
+			}
+		}
+	`
+
+	data, err := Do[MetaData](ctx, c, query, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query subgraph meta: %w", err)
+	}
+	if data.Meta == nil {
+		return nil, fmt.Errorf("subgraph returned no meta")
+	}
+
+	head := &Head{
+		Number:            data.Meta.Block.Number,
+		Hash:              data.Meta.Block.Hash,
+		Deployment:        data.Meta.Deployment,
+		HasIndexingErrors: data.Meta.HasIndexingErrors,
+	}
+
+	if c.rpcURL == "" {
+		return head, nil
+	}
+
+	ethClient, err := c.getEthClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to RPC: %w", err)
+	}
+	chainHead, err := ethClient.BlockNumber(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chain head: %w", err)
+	}
+	head.ChainHead = chainHead
+	if chainHead > head.Number {
+		head.Lag = chainHead - head.Number
+	}
+
+	return head, nil
+}
+
+// AtBlock returns a copy of the client whose typed queries are pinned to the
+// given block, so that several queries read a consistent state
+func (c *Client) AtBlock(number uint64) *Client {
+	pinned := *c
+	pinned.block = &number
+	return &pinned
+}
+
+// AtHead pins a copy of the client to the latest indexed block
+func (c *Client) AtHead(ctx context.Context) (*Client, *Head, error) {
+	head, err := c.Head(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return c.AtBlock(head.Number), head, nil
+}
+
+// Block returns the block the client is pinned to
+func (c *Client) Block() (uint64, bool) {
+	if c.block == nil {
+		return 0, false
+	}
+	return *c.block, true
+}
+
+// blockArgument returns the variable declaration and argument pinning a query to
+// block, and sets the block variable. Both are empty when block is nil.
+func blockArgument(block *uint64, vars map[string]interface{}) (string, string) {
+	if block == nil {
+		return "", ""
+	}
+	vars["block"] = *block
+	return ", $block: Int!", ", block: {number: $block}"
+}
@@ -0,0 +1,139 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"strings"
+)
+
+const (
+	// DefaultPageSize is the page size used by subgraphs when first is omitted
+	DefaultPageSize = 100
+	// MaxPageSize is the largest first value accepted by subgraphs
+	MaxPageSize = 1000
+)
+
+// ListOptions selects one page of a list query. Records are ordered by id, so
+// AfterID can be used as a cursor instead of Skip for deep pages.
+type ListOptions struct {
+	First   int    // Page size (default 100, max 1000)
+	Skip    int    // Number of records to skip
+	AfterID string // Only return records with id greater than AfterID (id_gt)
+}
+
+// PaginateOptions controls automatic paging through a list query
+type PaginateOptions struct {
+	PageSize   int // Records per request (default 1000)
+	MaxResults int // Stop after this many records (0 for no limit)
+}
+
+// variables adds the paging variables of the options to vars
+func (o ListOptions) variables(vars map[string]interface{}) error {
+	first := o.First
+	if first == 0 {
+		first = DefaultPageSize
+	}
+	if first < 0 || first > MaxPageSize {
+		return fmt.Errorf("first must be between 1 and %d, got %d", MaxPageSize, o.First)
+	}
+	if o.Skip < 0 {
+		return fmt.Errorf("skip cannot be negative, got %d", o.Skip)
+	}
+
+	vars["first"] = first
+	vars["skip"] = o.Skip
+	if o.AfterID != "" {
+		vars["after"] = o.AfterID
+	}
+	return nil
+}
+
+// paginate pages through fetch with an id_gt cursor, yielding every record until
+// a short page is returned or MaxResults is reached
+func paginate[T any](ctx context.Context, opts PaginateOptions, id func(T) string, fetch func(context.Context, ListOptions) ([]T, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		pageSize := opts.PageSize
+		if pageSize == 0 {
+			pageSize = MaxPageSize
+		}
+
+		var zero T
+		count := 0
+		page := ListOptions{First: pageSize}
+		for {
+			if opts.MaxResults > 0 && opts.MaxResults-count < page.First {
+				page.First = opts.MaxResults - count
+			}
+
+			records, err := fetch(ctx, page)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			for _, record := range records {
+				if !yield(record, nil) {
+					return
+				}
+				count++
+			}
+
+			if len(records) < page.First || (opts.MaxResults > 0 && count >= opts.MaxResults) {
+				return
+			}
+			page.AfterID = id(records[len(records)-1])
+		}
+	}
+}
+
+// collect gathers every record of seq into a slice
+func collect[T any](seq iter.Seq2[T, error]) ([]T, error) {
+	records := []T{}
+	for record, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// listQuery builds a paged query over entity and sets its variables in vars.
+// params declares the variables used by filters, e.g. "$account: String!", and
+// fields is the selection set. A non-nil block pins the query to that block.
+func listQuery(operation, entity, params string, filters []string, fields string, opts ListOptions, block *uint64, vars map[string]interface{}) (string, error) {
+	return orderedListQuery(operation, entity, params, filters, fields, "id", opts, block, vars)
+}
+
+// orderedListQuery is listQuery ordered by another field. Subgraphs only order
+// by one field, so records sharing a value of orderBy come in no defined order.
+func orderedListQuery(operation, entity, params string, filters []string, fields, orderBy string, opts ListOptions, block *uint64, vars map[string]interface{}) (string, error) {
+	if err := opts.variables(vars); err != nil {
+		return "", err
+	}
+
+	params += ", $first: Int!, $skip: Int!"
+	if opts.AfterID != "" {
+		params += ", $after: ID!"
+		filters = append(filters, "id_gt: $after")
+	}
+	blockParam, blockArg := blockArgument(block, vars)
+	if blockArg != "" {
+		blockArg = "\n\t\t\t\t" + strings.TrimPrefix(blockArg, ", ")
+	}
+
+	return fmt.Sprintf(`
+		query %s(%s%s) {
+			%s(
+				first: $first
+				skip: $skip
+				orderBy: %s
+				orderDirection: asc
+				where: {%s}%s
+			) {
+				%s
+			}
+		}
+	`, operation, params, blockParam, entity, orderBy, strings.Join(filters, ", "), blockArg, fields), nil
+}
@@ -0,0 +1,170 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// defaultReconcileBatchSize is the number of balance reads sent per batch request
+const defaultReconcileBatchSize = 100
+
+// balanceOfSelector is the ERC20 balanceOf(address) selector
+var balanceOfSelector = common.FromHex("0x70a08231")
+
+// ReconcileOptions selects the accounts to reconcile
+type ReconcileOptions struct {
+	PaymentAccounts    []string
+	CollectionAccounts []string
+	BatchSize          int // Balance reads per batch RPC request (default 100)
+}
+
+// BalanceCheck compares one indexed token balance with the chain
+type BalanceCheck struct {
+	Account string   `json:"account"`
+	Token   string   `json:"token"` // Zero address for ETH
+	Symbol  string   `json:"symbol"`
+	Indexed *big.Int `json:"indexed"` // Balance reported by the subgraph
+	OnChain *big.Int `json:"onChain"` // Balance read from the chain at the same block
+}
+
+// Matches reports whether the indexed balance equals the on-chain balance
+func (b BalanceCheck) Matches() bool {
+	return b.Indexed.Cmp(b.OnChain) == 0
+}
+
+// ReconcileReport lists the balances that differ between the subgraph and the chain
+type ReconcileReport struct {
+	Block      uint64         `json:"block"` // Block both sides were read at
+	Checked    int            `json:"checked"`
+	Mismatches []BalanceCheck `json:"mismatches"`
+}
+
+// Reconcile checks every token balance the subgraph reports for the given accounts
+// against the chain. Balances are read at the block the client is pinned to, or at
+// the latest indexed block otherwise, so both sides describe the same state.
+// RPCURL must be configured.
+func (c *Client) Reconcile(ctx context.Context, opts ReconcileOptions) (*ReconcileReport, error) {
+	rpcClient, err := c.getRPCClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to RPC: %w", err)
+	}
+
+	pinned := c
+	if _, ok := c.Block(); !ok {
+		pinned, _, err = c.AtHead(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	block, _ := pinned.Block()
+
+	var checks []BalanceCheck
+	payment, err := pinned.GetPaymentAccounts(ctx, opts.PaymentAccounts)
+	if err != nil {
+		return nil, err
+	}
+	for _, account := range payment {
+		checks = appendBalanceChecks(checks, account.ID, account.TokenBalances)
+	}
+	collection, err := pinned.GetCollectionAccounts(ctx, opts.CollectionAccounts)
+	if err != nil {
+		return nil, err
+	}
+	for _, account := range collection {
+		checks = appendBalanceChecks(checks, account.ID, account.TokenBalances)
+	}
+
+	if err := readBalances(ctx, rpcClient, block, checks, opts.BatchSize); err != nil {
+		return nil, err
+	}
+
+	report := &ReconcileReport{
+		Block:   block,
+		Checked: len(checks),
+	}
+	for _, check := range checks {
+		if !check.Matches() {
+			report.Mismatches = append(report.Mismatches, check)
+		}
+	}
+
+	return report, nil
+}
+
+func appendBalanceChecks(checks []BalanceCheck, account string, balances []TokenBalance) []BalanceCheck {
+	for _, balance := range balances {
+		indexed := new(big.Int)
+		if balance.Balance.Int != nil {
+			indexed.Set(balance.Balance.Int)
+		}
+		checks = append(checks, BalanceCheck{
+			Account: account,
+			Token:   balance.Token.ID,
+			Symbol:  balance.Token.Symbol,
+			Indexed: indexed,
+		})
+	}
+	return checks
+}
+
+// readBalances fills OnChain of every check with batched eth_call/eth_getBalance requests
+func readBalances(ctx context.Context, client *rpc.Client, block uint64, checks []BalanceCheck, batchSize int) error {
+	if batchSize <= 0 {
+		batchSize = defaultReconcileBatchSize
+	}
+	blockTag := hexutil.EncodeUint64(block)
+
+	for start := 0; start < len(checks); start += batchSize {
+		end := min(start+batchSize, len(checks))
+
+		elems := make([]rpc.BatchElem, end-start)
+		results := make([]hexutil.Bytes, end-start)
+		ethResults := make([]hexutil.Big, end-start)
+		for i := range elems {
+			check := checks[start+i]
+			account := common.HexToAddress(check.Account)
+			token := common.HexToAddress(check.Token)
+
+			if token == (common.Address{}) {
+				elems[i] = rpc.BatchElem{
+					Method: "eth_getBalance",
+					Args:   []interface{}{account, blockTag},
+					Result: &ethResults[i],
+				}
+				continue
+			}
+			data := append(append([]byte{}, balanceOfSelector...), common.LeftPadBytes(account.Bytes(), 32)...)
+			elems[i] = rpc.BatchElem{
+				Method: "eth_call",
+				Args:   []interface{}{map[string]interface{}{"to": token, "data": hexutil.Bytes(data)}, blockTag},
+				Result: &results[i],
+			}
+		}
+
+		if err := client.BatchCallContext(ctx, elems); err != nil {
+			return fmt.Errorf("failed to read on-chain balances: %w", err)
+		}
+
+		for i, elem := range elems {
+			check := &checks[start+i]
+			if elem.Error != nil {
+				return fmt.Errorf("failed to read %s balance of %s: %w", check.Token, check.Account, elem.Error)
+			}
+			if elem.Method == "eth_getBalance" {
+				check.OnChain = ethResults[i].ToInt()
+				continue
+			}
+			if len(results[i]) < 32 {
+				return fmt.Errorf("invalid balanceOf result from %s", check.Token)
+			}
+			check.OnChain = new(big.Int).SetBytes(results[i][:32])
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,173 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// defaultReceiptBatchSize is the number of receipts requested per batch request
+const defaultReceiptBatchSize = 100
+
+// transactionInfoFields selects all fields of a transactionInfo entity
+const transactionInfoFields = `
+				id
+				blockNumber
+				gasLimit
+				gasPrice
+				gasUsed
+				status
+				timestamp
+				transactionFee`
+
+// TransactionInfoResult is the outcome of looking up one transaction. Err is set
+// when the transaction is not indexed or its receipt could not be read; in the
+// latter case Info still holds the indexed data.
+type TransactionInfoResult struct {
+	Hash string
+	Info *TransactionInfo
+	Err  error
+}
+
+// rpcReceipt holds the receipt fields used for enrichment
+type rpcReceipt struct {
+	Status            hexutil.Uint64 `json:"status"`
+	GasUsed           hexutil.Uint64 `json:"gasUsed"`
+	EffectiveGasPrice *hexutil.Big   `json:"effectiveGasPrice"`
+}
+
+// GetTransactionInfos queries several transactions by hash. Results are returned
+// in the order of hashes. When RPCURL is configured the receipts are read with
+// batched eth_getTransactionReceipt requests to fill in gas used, status and the
+// effective gas price. The error is only set when the subgraph query fails.
+func (c *Client) GetTransactionInfos(ctx context.Context, hashes []string) ([]TransactionInfoResult, error) {
+	ids := make([]string, len(hashes))
+	for i, hash := range hashes {
+		ids[i] = normalizeHash(hash)
+	}
+
+	infos := make(map[string]*TransactionInfo, len(ids))
+	for start := 0; start < len(ids); start += MaxPageSize {
+		chunk := ids[start:min(start+MaxPageSize, len(ids))]
+		variables := map[string]interface{}{
+			"ids": chunk,
+		}
+		query, err := listQuery("GetTransactionInfos", "transactionInfos", "$ids: [ID!]",
+			[]string{"id_in: $ids"}, transactionInfoFields, ListOptions{First: len(chunk)}, c.block, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := Do[TransactionInfosData](ctx, c, query, variables)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query transaction infos: %w", err)
+		}
+		for i := range data.TransactionInfos {
+			info := &data.TransactionInfos[i]
+			infos[strings.ToLower(info.ID)] = info
+		}
+	}
+
+	results := make([]TransactionInfoResult, len(hashes))
+	var found []*TransactionInfoResult
+	for i, id := range ids {
+		results[i].Hash = hashes[i]
+		info, ok := infos[id]
+		if !ok {
+			results[i].Err = fmt.Errorf("transaction %s not found", hashes[i])
+			continue
+		}
+		// Duplicate hashes get their own copy
+		copied := *info
+		results[i].Info = &copied
+		found = append(found, &results[i])
+	}
+
+	if c.rpcURL != "" && len(found) > 0 {
+		c.enrichTransactionInfos(ctx, found)
+	}
+
+	return results, nil
+}
+
+// enrichTransactionInfos reads the receipts of the results in batches and applies
+// them, recording failures in the result of each transaction
+func (c *Client) enrichTransactionInfos(ctx context.Context, results []*TransactionInfoResult) {
+	rpcClient, err := c.getRPCClient(ctx)
+	if err != nil {
+		for _, result := range results {
+			result.Err = fmt.Errorf("failed to connect to RPC: %w", err)
+		}
+		return
+	}
+
+	for start := 0; start < len(results); start += defaultReceiptBatchSize {
+		chunk := results[start:min(start+defaultReceiptBatchSize, len(results))]
+
+		elems := make([]rpc.BatchElem, len(chunk))
+		receipts := make([]*rpcReceipt, len(chunk))
+		for i, result := range chunk {
+			elems[i] = rpc.BatchElem{
+				Method: "eth_getTransactionReceipt",
+				Args:   []interface{}{common.HexToHash(result.Info.ID)},
+				Result: &receipts[i],
+			}
+		}
+
+		if err := rpcClient.BatchCallContext(ctx, elems); err != nil {
+			for _, result := range chunk {
+				result.Err = fmt.Errorf("failed to get transaction receipt: %w", err)
+			}
+			continue
+		}
+
+		for i, result := range chunk {
+			switch {
+			case elems[i].Error != nil:
+				result.Err = fmt.Errorf("failed to get transaction receipt: %w", elems[i].Error)
+			case receipts[i] == nil:
+				result.Err = fmt.Errorf("receipt of transaction %s not found", result.Info.ID)
+			default:
+				applyReceipt(result.Info, receipts[i])
+			}
+		}
+	}
+}
+
+// applyReceipt fills in the fields of info known from its receipt
+func applyReceipt(info *TransactionInfo, receipt *rpcReceipt) {
+	gasUsed := uint64(receipt.GasUsed)
+	info.GasUsed = strconv.FormatUint(gasUsed, 10)
+	info.Status = strconv.FormatUint(uint64(receipt.Status), 10)
+
+	if info.GasLimit == "" {
+		info.GasLimit = info.GasUsed
+	}
+
+	// For EIP-1559 transactions the price paid differs from the fee cap
+	gasPrice, _ := new(big.Int).SetString(info.GasPrice, 10)
+	if receipt.EffectiveGasPrice != nil {
+		gasPrice = receipt.EffectiveGasPrice.ToInt()
+		info.EffectiveGasPrice = gasPrice.String()
+	}
+
+	if info.TransactionFee == "" && gasPrice != nil {
+		fee := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(gasUsed))
+		info.TransactionFee = fee.String()
+	}
+}
+
+// normalizeHash returns the lowercase 0x-prefixed form of a transaction hash
+func normalizeHash(hash string) string {
+	hash = strings.ToLower(strings.TrimSpace(hash))
+	if !strings.HasPrefix(hash, "0x") {
+		hash = "0x" + hash
+	}
+	return hash
+}
@@ -40,6 +40,7 @@ func (b BigInt) MarshalJSON() ([]byte, error) {
 
 // PaymentAllowance represents an allowance record from the graph
 type PaymentAllowance struct {
+	ID     string `json:"id"`     // Entity ID
 	Token  string `json:"token"`  // Token contract address
 	Owner  string `json:"owner"`  // Address that granted the allowance
 	Amount BigInt `json:"amount"` // Allowance amount
@@ -47,13 +48,17 @@ type PaymentAllowance struct {
 
 // PaymentAllowancesResponse represents the GraphQL response for paymentAllowances query
 type PaymentAllowancesResponse struct {
-	Data struct {
-		PaymentAllowances []PaymentAllowance `json:"paymentAllowances"`
-	} `json:"data"`
+	Data PaymentAllowancesData `json:"data"`
+}
+
+// PaymentAllowancesData is the data of the paymentAllowances query
+type PaymentAllowancesData struct {
+	PaymentAllowances []PaymentAllowance `json:"paymentAllowances"`
 }
 
 // PaymentApproval represents an approval event for a payment account
 type PaymentApproval struct {
+	ID        string `json:"id"`        // Entity ID
 	Token     string `json:"token"`     // Token contract address
 	Spender   string `json:"spender"`   // Spender address
 	Amount    BigInt `json:"amount"`    // Approved amount
@@ -63,32 +68,45 @@ type PaymentApproval struct {
 
 // PaymentApprovalsResponse represents GraphQL response for payment approvals
 type PaymentApprovalsResponse struct {
-	Data struct {
-		PaymentApprovals []PaymentApproval `json:"paymentApprovals"`
-	} `json:"data"`
+	Data PaymentApprovalsData `json:"data"`
+}
+
+// PaymentApprovalsData is the data of the paymentApprovals query
+type PaymentApprovalsData struct {
+	PaymentApprovals []PaymentApproval `json:"paymentApprovals"`
 }
 
 // TransactionInfo represents detailed info of a transaction returned by the graph
 type TransactionInfo struct {
-	ID             string `json:"id"`             // Transaction hash
-	BlockNumber    string `json:"blockNumber"`    // Block number containing the transaction
-	GasLimit       string `json:"gasLimit"`       // Gas limit set for the transaction
-	GasPrice       string `json:"gasPrice"`       // Gas price used for the transaction
-	GasUsed        string `json:"gasUsed"`        // Gas actually used
-	Status         string `json:"status"`         // Transaction execution status
-	Timestamp      string `json:"timestamp"`      // Block timestamp
-	TransactionFee string `json:"transactionFee"` // Total transaction fee
+	ID                string `json:"id"`                          // Transaction hash
+	BlockNumber       string `json:"blockNumber"`                 // Block number containing the transaction
+	GasLimit          string `json:"gasLimit"`                    // Gas limit set for the transaction
+	GasPrice          string `json:"gasPrice"`                    // Gas price used for the transaction
+	GasUsed           string `json:"gasUsed"`                     // Gas actually used
+	Status            string `json:"status"`                      // Transaction execution status
+	Timestamp         string `json:"timestamp"`                   // Block timestamp
+	TransactionFee    string `json:"transactionFee"`              // Total transaction fee
+	EffectiveGasPrice string `json:"effectiveGasPrice,omitempty"` // Price paid per gas, read from the receipt
 }
 
 // TransactionInfoResponse represents the GraphQL response for transactionInfo query
 type TransactionInfoResponse struct {
-	Data struct {
-		TransactionInfo *TransactionInfo `json:"transactionInfo"`
-	} `json:"data"`
+	Data TransactionInfoData `json:"data"`
+}
+
+// TransactionInfoData is the data of the transactionInfo query
+type TransactionInfoData struct {
+	TransactionInfo *TransactionInfo `json:"transactionInfo"`
+}
+
+// TransactionInfosData is the data of the transactionInfos query
+type TransactionInfosData struct {
+	TransactionInfos []TransactionInfo `json:"transactionInfos"`
 }
 
 // TokenMetadata represents ERC20 token metadata
 type TokenMetadata struct {
+	ID       string `json:"id"` // Token contract address
 	Name     string `json:"name"`
 	Symbol   string `json:"symbol"`
 	Decimals int32  `json:"decimals,string"`
@@ -115,16 +133,22 @@ type CollectionAccount struct {
 
 // PaymentAccountsResponse represents GraphQL response for paymentAccounts query
 type PaymentAccountsResponse struct {
-	Data struct {
-		PaymentAccounts []PaymentAccount `json:"paymentAccounts"`
-	} `json:"data"`
+	Data PaymentAccountsData `json:"data"`
+}
+
+// PaymentAccountsData is the data of the paymentAccounts query
+type PaymentAccountsData struct {
+	PaymentAccounts []PaymentAccount `json:"paymentAccounts"`
 }
 
 // CollectionAccountsResponse represents GraphQL response for collectionAccounts query
 type CollectionAccountsResponse struct {
-	Data struct {
-		CollectionAccounts []CollectionAccount `json:"collectionAccounts"`
-	} `json:"data"`
+	Data CollectionAccountsData `json:"data"`
+}
+
+// CollectionAccountsData is the data of the collectionAccounts query
+type CollectionAccountsData struct {
+	CollectionAccounts []CollectionAccount `json:"collectionAccounts"`
 }
 
 // PaymentAuthorizations aggregates allowance and approval data
@@ -139,13 +163,22 @@ type GraphQLRequest struct {
 	Variables map[string]interface{} `json:"variables,omitempty"`
 }
 
-// GraphQLError represents a GraphQL error
-type GraphQLError struct {
-	Message string `json:"message"`
+// ErrorLocation is a position in the query an error refers to
+type ErrorLocation struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+// ErrorDetail represents one entry of the errors list of a GraphQL response
+type ErrorDetail struct {
+	Message    string                 `json:"message"`
+	Locations  []ErrorLocation        `json:"locations,omitempty"`
+	Path       []interface{}          `json:"path,omitempty"` // Field names and list indices
+	Extensions map[string]interface{} `json:"extensions,omitempty"`
 }
 
 // GraphQLResponse represents a generic GraphQL response
 type GraphQLResponse struct {
-	Data   interface{}    `json:"data"`
-	Errors []GraphQLError `json:"errors,omitempty"`
+	Data   json.RawMessage `json:"data"`
+	Errors []ErrorDetail   `json:"errors,omitempty"`
 }
@@ -0,0 +1,187 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+)
+
+// WatchPaymentApprovals polls the subgraph every interval and sends approvals granted
+// by a payment account that were not delivered before. The cursor of the last
+// delivered approval is saved in the configured CheckpointStore, so a restarted
+// watcher resumes after it; without a checkpoint all existing approvals are sent first.
+// Query errors are sent on the error channel and polling continues, so both channels
+// must be drained. Both are closed when ctx is done.
+func (c *Client) WatchPaymentApprovals(ctx context.Context, account string, interval time.Duration) (<-chan PaymentApproval, <-chan error, error) {
+	accountID := normalizeAddress(account)
+	if accountID == "" {
+		return nil, nil, fmt.Errorf("invalid payment account address: %s", account)
+	}
+	if interval <= 0 {
+		return nil, nil, fmt.Errorf("interval must be positive, got %s", interval)
+	}
+
+	key := "paymentApprovals:" + accountID
+	cursor, ok, err := c.checkpoints.Load(ctx, key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load checkpoint: %w", err)
+	}
+	if !ok {
+		cursor = Cursor{Timestamp: "0"}
+	}
+
+	// Watching always follows the latest indexed block
+	live := *c
+	live.block = nil
+
+	approvals := make(chan PaymentApproval)
+	errs := make(chan error)
+	go func() {
+		defer close(approvals)
+		defer close(errs)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		emit := func(approval PaymentApproval) error {
+			select {
+			case approvals <- approval:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			cursor = Cursor{Timestamp: approval.Timestamp, ID: approval.ID}
+			if err := c.checkpoints.Save(ctx, key, cursor); err != nil {
+				return fmt.Errorf("failed to save checkpoint: %w", err)
+			}
+			return nil
+		}
+
+		for {
+			if err := live.pollPaymentApprovals(ctx, accountID, &cursor, emit); err != nil && ctx.Err() == nil {
+				select {
+				case errs <- err:
+				case <-ctx.Done():
+				}
+			}
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return approvals, errs, nil
+}
+
+// pollPaymentApprovals emits every approval after cursor in (timestamp, id) order
+func (c *Client) pollPaymentApprovals(ctx context.Context, account string, cursor *Cursor, emit func(PaymentApproval) error) error {
+	// Approvals sharing the cursor timestamp that were not delivered yet
+	if cursor.ID != "" {
+		if err := c.emitPaymentApprovalsAt(ctx, account, cursor.Timestamp, cursor.ID, emit); err != nil {
+			return err
+		}
+	}
+
+	for {
+		approvals, err := c.getPaymentApprovalsAfter(ctx, account, cursor.Timestamp, MaxPageSize)
+		if err != nil {
+			return err
+		}
+
+		// The last timestamp of a full page may continue on the next page, so it is
+		// delivered separately in id order
+		var partial string
+		if len(approvals) == MaxPageSize {
+			partial = approvals[len(approvals)-1].Timestamp
+			for len(approvals) > 0 && approvals[len(approvals)-1].Timestamp == partial {
+				approvals = approvals[:len(approvals)-1]
+			}
+		}
+
+		// Subgraphs order by timestamp only, ties are ordered by id here
+		sort.SliceStable(approvals, func(i, j int) bool {
+			if order := compareBigInts(approvals[i].Timestamp, approvals[j].Timestamp); order != 0 {
+				return order < 0
+			}
+			return approvals[i].ID < approvals[j].ID
+		})
+		for _, approval := range approvals {
+			if err := emit(approval); err != nil {
+				return err
+			}
+		}
+
+		if partial == "" {
+			return nil
+		}
+		if err := c.emitPaymentApprovalsAt(ctx, account, partial, "", emit); err != nil {
+			return err
+		}
+	}
+}
+
+// emitPaymentApprovalsAt emits the approvals with the given timestamp and an id
+// greater than afterID, in id order
+func (c *Client) emitPaymentApprovalsAt(ctx context.Context, account, timestamp, afterID string, emit func(PaymentApproval) error) error {
+	for {
+		approvals, err := c.getPaymentApprovalsAt(ctx, account, timestamp, ListOptions{First: MaxPageSize, AfterID: afterID})
+		if err != nil {
+			return err
+		}
+		for _, approval := range approvals {
+			if err := emit(approval); err != nil {
+				return err
+			}
+		}
+		if len(approvals) < MaxPageSize {
+			return nil
+		}
+		afterID = approvals[len(approvals)-1].ID
+	}
+}
+
+// getPaymentApprovalsAt queries one page of approvals with the given timestamp in id order
+func (c *Client) getPaymentApprovalsAt(ctx context.Context, account, timestamp string, opts ListOptions) ([]PaymentApproval, error) {
+	variables := map[string]interface{}{
+		"account":   account,
+		"timestamp": timestamp,
+	}
+	query, err := listQuery("GetPaymentApprovalsAt", "paymentApprovals", "$account: String!, $timestamp: BigInt!",
+		[]string{"account: $account", "timestamp: $timestamp"}, "id token spender amount timestamp txHash", opts, c.block, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.queryPaymentApprovals(ctx, query, variables)
+}
+
+// getPaymentApprovalsAfter queries the first approvals newer than timestamp in
+// timestamp order
+func (c *Client) getPaymentApprovalsAfter(ctx context.Context, account, timestamp string, first int) ([]PaymentApproval, error) {
+	variables := map[string]interface{}{
+		"account":   account,
+		"timestamp": timestamp,
+	}
+	query, err := orderedListQuery("GetPaymentApprovalsAfter", "paymentApprovals", "$account: String!, $timestamp: BigInt!",
+		[]string{"account: $account", "timestamp_gt: $timestamp"}, "id token spender amount timestamp txHash", "timestamp", ListOptions{First: first}, c.block, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.queryPaymentApprovals(ctx, query, variables)
+}
+
+// compareBigInts compares two decimal BigInt strings
+func compareBigInts(a, b string) int {
+	x, okA := new(big.Int).SetString(a, 10)
+	y, okB := new(big.Int).SetString(b, 10)
+	if !okA || !okB {
+		return strings.Compare(a, b)
+	}
+	return x.Cmp(y)
+}
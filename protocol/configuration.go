@@ -0,0 +1,443 @@
+package protocol
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/vikkkko/safe-core-sdk-golang/protocol/utils"
+	"github.com/vikkkko/safe-core-sdk-golang/types"
+)
+
+// sentinelAddress marks the head of the Safe owner and module linked lists
+var sentinelAddress = common.HexToAddress("0x0000000000000000000000000000000000000001")
+
+// ConfigurationChangeType identifies the Safe method a configuration change calls
+type ConfigurationChangeType string
+
+const (
+	ConfigurationAddOwner           ConfigurationChangeType = "addOwnerWithThreshold"
+	ConfigurationRemoveOwner        ConfigurationChangeType = "removeOwner"
+	ConfigurationSwapOwner          ConfigurationChangeType = "swapOwner"
+	ConfigurationChangeThreshold    ConfigurationChangeType = "changeThreshold"
+	ConfigurationEnableModule       ConfigurationChangeType = "enableModule"
+	ConfigurationDisableModule      ConfigurationChangeType = "disableModule"
+	ConfigurationSetGuard           ConfigurationChangeType = "setGuard"
+	ConfigurationSetFallbackHandler ConfigurationChangeType = "setFallbackHandler"
+)
+
+// SafeConfigurationState represents the on-chain configuration of a Safe
+type SafeConfigurationState struct {
+	Owners          []common.Address `json:"owners"`          // Owners in linked list order
+	Threshold       uint             `json:"threshold"`       // Number of required confirmations
+	Modules         []common.Address `json:"modules"`         // Enabled modules in linked list order
+	Guard           common.Address   `json:"guard"`           // Transaction guard (zero if none)
+	FallbackHandler common.Address   `json:"fallbackHandler"` // Fallback handler (zero if none)
+}
+
+// DesiredSafeConfiguration represents the configuration a Safe should converge to.
+// Nil fields are left unmanaged and keep their on-chain value.
+type DesiredSafeConfiguration struct {
+	Owners           []common.Address `json:"owners,omitempty"`           // Desired owner set (order is not enforced)
+	Threshold        uint             `json:"threshold,omitempty"`        // Desired threshold (0 keeps the current one)
+	Modules          []common.Address `json:"modules,omitempty"`          // Desired module set (empty slice disables all modules)
+	Guard            *common.Address  `json:"guard,omitempty"`            // Desired guard (zero address removes the guard)
+	FallbackHandler  *common.Address  `json:"fallbackHandler,omitempty"`  // Desired fallback handler (zero address removes it)
+	MultiSendAddress string           `json:"multiSendAddress,omitempty"` // MultiSendCallOnly used for batching (optional)
+	UnsafeGuard      bool             `json:"unsafeGuard,omitempty"`      // Skip guard safety checks
+}
+
+// ConfigurationChange represents a single Safe call in a configuration plan
+type ConfigurationChange struct {
+	Type        ConfigurationChangeType   `json:"type"`        // Safe method being called
+	Description string                    `json:"description"` // Human-readable summary
+	Transaction types.MetaTransactionData `json:"transaction"` // Call to the Safe itself
+}
+
+// ConfigurationPlan represents the ordered changes needed to reach a desired configuration
+type ConfigurationPlan struct {
+	SafeAddress common.Address         `json:"safeAddress"`
+	Current     SafeConfigurationState `json:"current"`
+	Changes     []ConfigurationChange  `json:"changes"`
+	Transaction *types.SafeTransaction `json:"transaction,omitempty"` // Nil when no changes are needed
+}
+
+// IsEmpty reports whether the Safe already matches the desired configuration
+func (p *ConfigurationPlan) IsEmpty() bool {
+	return len(p.Changes) == 0
+}
+
+// String returns a human-readable description of the plan
+func (p *ConfigurationPlan) String() string {
+	var b strings.Builder
+
+	if p.IsEmpty() {
+		fmt.Fprintf(&b, "Safe %s matches the desired configuration, no changes needed\n", p.SafeAddress.Hex())
+		return b.String()
+	}
+
+	fmt.Fprintf(&b, "Safe %s: %d change(s)\n", p.SafeAddress.Hex(), len(p.Changes))
+	for i, change := range p.Changes {
+		fmt.Fprintf(&b, "  %d. %s: %s\n", i+1, change.Type, change.Description)
+	}
+
+	if p.Transaction != nil {
+		if len(p.Changes) > 1 {
+			fmt.Fprintf(&b, "Batched into one MultiSend Safe transaction via %s (nonce %d)\n", p.Transaction.Data.To, p.Transaction.Data.Nonce)
+		} else {
+			fmt.Fprintf(&b, "Executed as one Safe transaction (nonce %d)\n", p.Transaction.Data.Nonce)
+		}
+	}
+
+	return b.String()
+}
+
+// GetConfigurationState reads the owners, threshold, modules, guard and fallback handler of the Safe
+func (s *Safe) GetConfigurationState(ctx context.Context) (*SafeConfigurationState, error) {
+	owners, err := s.ownerManager.GetOwners(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get owners: %w", err)
+	}
+
+	threshold, err := s.GetThreshold(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get threshold: %w", err)
+	}
+
+	modules, err := s.moduleManager.GetModules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get modules: %w", err)
+	}
+
+	guard, err := s.guardManager.GetGuard(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get guard: %w", err)
+	}
+
+	fallbackHandler, err := s.fallbackManager.GetFallbackHandler(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get fallback handler: %w", err)
+	}
+
+	return &SafeConfigurationState{
+		Owners:          owners,
+		Threshold:       threshold,
+		Modules:         modules,
+		Guard:           guard,
+		FallbackHandler: fallbackHandler,
+	}, nil
+}
+
+// PlanConfiguration diffs the desired configuration against on-chain state and
+// builds the Safe transaction applying it. Multiple changes are batched into a
+// single MultiSendCallOnly delegatecall.
+func (s *Safe) PlanConfiguration(ctx context.Context, desired DesiredSafeConfiguration) (*ConfigurationPlan, error) {
+	current, err := s.GetConfigurationState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get configuration state: %w", err)
+	}
+
+	changes, err := PlanConfigurationChanges(s.GetAddress(), *current, desired)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &ConfigurationPlan{
+		SafeAddress: s.GetAddress(),
+		Current:     *current,
+		Changes:     changes,
+	}
+	if plan.IsEmpty() {
+		return plan, nil
+	}
+
+	if desired.Guard != nil && *desired.Guard != current.Guard && *desired.Guard != (common.Address{}) && !desired.UnsafeGuard {
+		if err := s.guardManager.CheckGuard(ctx, *desired.Guard); err != nil {
+			return nil, fmt.Errorf("guard safety check failed: %w", err)
+		}
+	}
+
+	txData := types.SafeTransactionDataPartial{
+		To:    changes[0].Transaction.To,
+		Value: "0",
+		Data:  changes[0].Transaction.Data,
+	}
+
+	if len(changes) > 1 {
+		multiSendAddress, err := s.configurationMultiSendAddress(desired.MultiSendAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		metaTxs := make([]types.MetaTransactionData, len(changes))
+		for i, change := range changes {
+			metaTxs[i] = change.Transaction
+		}
+
+		data, err := utils.CreateMultiSendData(metaTxs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create MultiSend data: %w", err)
+		}
+
+		operation := types.DelegateCall
+		txData = types.SafeTransactionDataPartial{
+			To:        multiSendAddress.Hex(),
+			Value:     "0",
+			Data:      hexutil.Encode(data),
+			Operation: &operation,
+		}
+	}
+
+	tx, err := s.CreateTransaction(ctx, txData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create configuration transaction: %w", err)
+	}
+	plan.Transaction = tx
+
+	return plan, nil
+}
+
+// configurationMultiSendAddress resolves the MultiSendCallOnly used to batch configuration changes
+func (s *Safe) configurationMultiSendAddress(override string) (common.Address, error) {
+	if override != "" {
+		if !common.IsHexAddress(override) {
+			return common.Address{}, fmt.Errorf("invalid MultiSend address: %s", override)
+		}
+		return common.HexToAddress(override), nil
+	}
+
+	multiSend, err := s.contractManager.GetMultiSendCallOnlyContract(types.DefaultSafeVersion)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to get MultiSendCallOnly contract: %w", err)
+	}
+
+	return multiSend.Address(), nil
+}
+
+// PlanConfigurationChanges computes the minimal ordered list of Safe calls that turn
+// the current configuration into the desired one. Owner and module calls use
+// prev pointers from the linked list as it looks after the preceding calls.
+func PlanConfigurationChanges(safeAddress common.Address, current SafeConfigurationState, desired DesiredSafeConfiguration) ([]ConfigurationChange, error) {
+	planner := &configurationPlanner{safeAddress: safeAddress}
+
+	if err := planner.planOwners(current, desired); err != nil {
+		return nil, err
+	}
+
+	if desired.Modules != nil {
+		if err := planner.planModules(current.Modules, desired.Modules); err != nil {
+			return nil, err
+		}
+	}
+
+	if desired.FallbackHandler != nil && *desired.FallbackHandler != current.FallbackHandler {
+		if *desired.FallbackHandler == safeAddress {
+			return nil, fmt.Errorf("fallback handler cannot be the Safe itself")
+		}
+		data, err := utils.SafeSetFallbackHandlerData(*desired.FallbackHandler)
+		if err != nil {
+			return nil, err
+		}
+		planner.add(ConfigurationSetFallbackHandler, fmt.Sprintf("set fallback handler %s (was %s)", desired.FallbackHandler.Hex(), current.FallbackHandler.Hex()), data)
+	}
+
+	// The guard goes last so it only applies to transactions after this one
+	if desired.Guard != nil && *desired.Guard != current.Guard {
+		data, err := utils.SafeSetGuardData(*desired.Guard)
+		if err != nil {
+			return nil, err
+		}
+		description := fmt.Sprintf("set guard %s (was %s)", desired.Guard.Hex(), current.Guard.Hex())
+		if *desired.Guard == (common.Address{}) {
+			description = fmt.Sprintf("remove guard %s", current.Guard.Hex())
+		}
+		planner.add(ConfigurationSetGuard, description, data)
+	}
+
+	return planner.changes, nil
+}
+
+// configurationPlanner accumulates configuration changes targeting a Safe
+type configurationPlanner struct {
+	safeAddress common.Address
+	changes     []ConfigurationChange
+}
+
+func (p *configurationPlanner) add(changeType ConfigurationChangeType, description string, data []byte) {
+	p.changes = append(p.changes, ConfigurationChange{
+		Type:        changeType,
+		Description: description,
+		Transaction: types.MetaTransactionData{
+			To:    p.safeAddress.Hex(),
+			Value: "0",
+			Data:  hexutil.Encode(data),
+		},
+	})
+}
+
+// planOwners pairs removed and added owners into swaps, then adds before removing
+// so the threshold never has to exceed the owner count mid-batch
+func (p *configurationPlanner) planOwners(current SafeConfigurationState, desired DesiredSafeConfiguration) error {
+	owners := append([]common.Address(nil), current.Owners...)
+	finalOwners := owners
+	if desired.Owners != nil {
+		if err := p.validateMembers("owner", desired.Owners); err != nil {
+			return err
+		}
+		finalOwners = desired.Owners
+	}
+
+	target := current.Threshold
+	if desired.Threshold != 0 {
+		target = desired.Threshold
+	}
+	if target == 0 || target > uint(len(finalOwners)) {
+		return fmt.Errorf("threshold (%d) must be between 1 and the number of owners (%d)", target, len(finalOwners))
+	}
+
+	toRemove := addressDifference(owners, finalOwners)
+	toAdd := addressDifference(finalOwners, owners)
+	threshold := current.Threshold
+
+	swaps := len(toRemove)
+	if len(toAdd) < swaps {
+		swaps = len(toAdd)
+	}
+
+	for i := 0; i < swaps; i++ {
+		oldOwner, newOwner := toRemove[i], toAdd[i]
+		index := addressIndex(owners, oldOwner)
+		data, err := utils.SafeSwapOwnerData(linkedListPrev(owners, index), oldOwner, newOwner)
+		if err != nil {
+			return err
+		}
+		owners[index] = newOwner
+		p.add(ConfigurationSwapOwner, fmt.Sprintf("replace owner %s with %s", oldOwner.Hex(), newOwner.Hex()), data)
+	}
+
+	for _, owner := range toAdd[swaps:] {
+		threshold = minUint(target, uint(len(owners)+1))
+		data, err := utils.SafeAddOwnerWithThresholdData(owner, new(big.Int).SetUint64(uint64(threshold)))
+		if err != nil {
+			return err
+		}
+		owners = append([]common.Address{owner}, owners...)
+		p.add(ConfigurationAddOwner, fmt.Sprintf("add owner %s, threshold %d of %d", owner.Hex(), threshold, len(owners)), data)
+	}
+
+	for _, owner := range toRemove[swaps:] {
+		index := addressIndex(owners, owner)
+		threshold = minUint(target, uint(len(owners)-1))
+		data, err := utils.SafeRemoveOwnerData(linkedListPrev(owners, index), owner, new(big.Int).SetUint64(uint64(threshold)))
+		if err != nil {
+			return err
+		}
+		owners = append(owners[:index:index], owners[index+1:]...)
+		p.add(ConfigurationRemoveOwner, fmt.Sprintf("remove owner %s, threshold %d of %d", owner.Hex(), threshold, len(owners)), data)
+	}
+
+	if threshold != target {
+		data, err := utils.SafeChangeThresholdData(new(big.Int).SetUint64(uint64(target)))
+		if err != nil {
+			return err
+		}
+		p.add(ConfigurationChangeThreshold, fmt.Sprintf("change threshold from %d to %d of %d", threshold, target, len(owners)), data)
+	}
+
+	return nil
+}
+
+// planModules disables modules that are no longer wanted and enables missing ones
+func (p *configurationPlanner) planModules(current, desired []common.Address) error {
+	if err := p.validateMembers("module", desired); err != nil {
+		return err
+	}
+
+	modules := append([]common.Address(nil), current...)
+
+	for _, module := range addressDifference(current, desired) {
+		index := addressIndex(modules, module)
+		data, err := utils.SafeDisableModuleData(linkedListPrev(modules, index), module)
+		if err != nil {
+			return err
+		}
+		modules = append(modules[:index:index], modules[index+1:]...)
+		p.add(ConfigurationDisableModule, fmt.Sprintf("disable module %s", module.Hex()), data)
+	}
+
+	for _, module := range addressDifference(desired, current) {
+		data, err := utils.SafeEnableModuleData(module)
+		if err != nil {
+			return err
+		}
+		modules = append([]common.Address{module}, modules...)
+		p.add(ConfigurationEnableModule, fmt.Sprintf("enable module %s", module.Hex()), data)
+	}
+
+	return nil
+}
+
+// validateMembers rejects addresses the Safe linked lists cannot hold
+func (p *configurationPlanner) validateMembers(kind string, addresses []common.Address) error {
+	if kind == "owner" && len(addresses) == 0 {
+		return fmt.Errorf("desired owners cannot be empty")
+	}
+
+	seen := make(map[common.Address]bool, len(addresses))
+	for _, address := range addresses {
+		switch {
+		case address == (common.Address{}) || address == sentinelAddress:
+			return fmt.Errorf("invalid %s address: %s", kind, address.Hex())
+		case kind == "owner" && address == p.safeAddress:
+			return fmt.Errorf("the Safe cannot be its own owner")
+		case seen[address]:
+			return fmt.Errorf("duplicate %s address: %s", kind, address.Hex())
+		}
+		seen[address] = true
+	}
+
+	return nil
+}
+
+// addressDifference returns the addresses in a that are not in b, keeping the order of a
+func addressDifference(a, b []common.Address) []common.Address {
+	exclude := make(map[common.Address]bool, len(b))
+	for _, address := range b {
+		exclude[address] = true
+	}
+
+	var diff []common.Address
+	for _, address := range a {
+		if !exclude[address] {
+			diff = append(diff, address)
+		}
+	}
+	return diff
+}
+
+func addressIndex(addresses []common.Address, target common.Address) int {
+	for i, address := range addresses {
+		if address == target {
+			return i
+		}
+	}
+	return -1
+}
+
+// linkedListPrev returns the entry pointing to addresses[index] in a Safe linked list
+func linkedListPrev(addresses []common.Address, index int) common.Address {
+	if index == 0 {
+		return sentinelAddress
+	}
+	return addresses[index-1]
+}
+
+func minUint(a, b uint) uint {
+	if a < b {
+		return a
+	}
+	return b
+}
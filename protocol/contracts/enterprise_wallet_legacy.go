@@ -0,0 +1,239 @@
+package contracts
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// The EnterpriseWallet binding was regenerated for the current contract, whose
+// structs are declared in the AccountManagement, EnterpriseWalletTypes and
+// SuperAdminManagement interfaces. The previous struct names remain as aliases.
+
+// IEnterpriseWalletAccountInfo is the previous name of IAccountManagementAccountInfo.
+//
+// Deprecated: use IAccountManagementAccountInfo.
+type IEnterpriseWalletAccountInfo = IAccountManagementAccountInfo
+
+// IEnterpriseWalletMethodConfig is the previous name of IEnterpriseWalletTypesMethodConfig.
+//
+// Deprecated: use IEnterpriseWalletTypesMethodConfig.
+type IEnterpriseWalletMethodConfig = IEnterpriseWalletTypesMethodConfig
+
+// IEnterpriseWalletSuperAdminTransfer is the previous name of ISuperAdminManagementSuperAdminTransfer.
+//
+// Deprecated: use ISuperAdminManagementSuperAdminTransfer.
+type IEnterpriseWalletSuperAdminTransfer = ISuperAdminManagementSuperAdminTransfer
+
+// enterpriseWalletLegacyABI holds the AllowanceSet event removed from the current
+// EnterpriseWallet contract, so that logs of older wallets can still be decoded.
+const enterpriseWalletLegacyABI = `[
+	{"type":"event","name":"AllowanceSet","inputs":[{"name":"paymentAccount","type":"address","indexed":true},{"name":"token","type":"address","indexed":true},{"name":"amount","type":"uint256","indexed":false}],"anonymous":false}
+]`
+
+// ErrEnterpriseWalletMethodRemoved is returned by the deprecated wrappers for
+// methods and events the current EnterpriseWallet no longer has
+var ErrEnterpriseWalletMethodRemoved = fmt.Errorf("method was removed from the EnterpriseWallet contract")
+
+// GetAllowance is the binding of the removed getAllowance method.
+//
+// Deprecated: allowances are no longer tracked by EnterpriseWallet; always returns ErrEnterpriseWalletMethodRemoved.
+func (_EnterpriseWallet *EnterpriseWalletCaller) GetAllowance(opts *bind.CallOpts, token common.Address, paymentAccount common.Address) (*big.Int, error) {
+	return nil, fmt.Errorf("getAllowance: %w", ErrEnterpriseWalletMethodRemoved)
+}
+
+// GetAllowance is the binding of the removed getAllowance method.
+//
+// Deprecated: allowances are no longer tracked by EnterpriseWallet; always returns ErrEnterpriseWalletMethodRemoved.
+func (_EnterpriseWallet *EnterpriseWalletSession) GetAllowance(token common.Address, paymentAccount common.Address) (*big.Int, error) {
+	return _EnterpriseWallet.Contract.GetAllowance(&_EnterpriseWallet.CallOpts, token, paymentAccount)
+}
+
+// GetAllowance is the binding of the removed getAllowance method.
+//
+// Deprecated: allowances are no longer tracked by EnterpriseWallet; always returns ErrEnterpriseWalletMethodRemoved.
+func (_EnterpriseWallet *EnterpriseWalletCallerSession) GetAllowance(token common.Address, paymentAccount common.Address) (*big.Int, error) {
+	return _EnterpriseWallet.Contract.GetAllowance(&_EnterpriseWallet.CallOpts, token, paymentAccount)
+}
+
+// GetCurrentSuperAdminTransferNonce is the binding of the removed getCurrentSuperAdminTransferNonce method.
+//
+// Deprecated: the current contract keeps a single pending transfer, see GetSuperAdminTransfer; always returns ErrEnterpriseWalletMethodRemoved.
+func (_EnterpriseWallet *EnterpriseWalletCaller) GetCurrentSuperAdminTransferNonce(opts *bind.CallOpts) (*big.Int, error) {
+	return nil, fmt.Errorf("getCurrentSuperAdminTransferNonce: %w", ErrEnterpriseWalletMethodRemoved)
+}
+
+// GetCurrentSuperAdminTransferNonce is the binding of the removed getCurrentSuperAdminTransferNonce method.
+//
+// Deprecated: the current contract keeps a single pending transfer, see GetSuperAdminTransfer; always returns ErrEnterpriseWalletMethodRemoved.
+func (_EnterpriseWallet *EnterpriseWalletSession) GetCurrentSuperAdminTransferNonce() (*big.Int, error) {
+	return _EnterpriseWallet.Contract.GetCurrentSuperAdminTransferNonce(&_EnterpriseWallet.CallOpts)
+}
+
+// GetCurrentSuperAdminTransferNonce is the binding of the removed getCurrentSuperAdminTransferNonce method.
+//
+// Deprecated: the current contract keeps a single pending transfer, see GetSuperAdminTransfer; always returns ErrEnterpriseWalletMethodRemoved.
+func (_EnterpriseWallet *EnterpriseWalletCallerSession) GetCurrentSuperAdminTransferNonce() (*big.Int, error) {
+	return _EnterpriseWallet.Contract.GetCurrentSuperAdminTransferNonce(&_EnterpriseWallet.CallOpts)
+}
+
+// ApproveTokenForPayment is the binding of the removed approveTokenForPayment method.
+//
+// Deprecated: not supported by the current EnterpriseWallet contract; always returns ErrEnterpriseWalletMethodRemoved.
+func (_EnterpriseWallet *EnterpriseWalletTransactor) ApproveTokenForPayment(opts *bind.TransactOpts, token common.Address, paymentAccount common.Address, amount *big.Int) (*types.Transaction, error) {
+	return nil, fmt.Errorf("approveTokenForPayment: %w", ErrEnterpriseWalletMethodRemoved)
+}
+
+// ApproveTokenForPayment is the binding of the removed approveTokenForPayment method.
+//
+// Deprecated: not supported by the current EnterpriseWallet contract; always returns ErrEnterpriseWalletMethodRemoved.
+func (_EnterpriseWallet *EnterpriseWalletSession) ApproveTokenForPayment(token common.Address, paymentAccount common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _EnterpriseWallet.Contract.ApproveTokenForPayment(&_EnterpriseWallet.TransactOpts, token, paymentAccount, amount)
+}
+
+// ApproveTokenForPayment is the binding of the removed approveTokenForPayment method.
+//
+// Deprecated: not supported by the current EnterpriseWallet contract; always returns ErrEnterpriseWalletMethodRemoved.
+func (_EnterpriseWallet *EnterpriseWalletTransactorSession) ApproveTokenForPayment(token common.Address, paymentAccount common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _EnterpriseWallet.Contract.ApproveTokenForPayment(&_EnterpriseWallet.TransactOpts, token, paymentAccount, amount)
+}
+
+// TransferETHToPayment is the binding of the removed transferETHToPayment method.
+//
+// Deprecated: not supported by the current EnterpriseWallet contract; always returns ErrEnterpriseWalletMethodRemoved.
+func (_EnterpriseWallet *EnterpriseWalletTransactor) TransferETHToPayment(opts *bind.TransactOpts, paymentAccount common.Address, amount *big.Int) (*types.Transaction, error) {
+	return nil, fmt.Errorf("transferETHToPayment: %w", ErrEnterpriseWalletMethodRemoved)
+}
+
+// TransferETHToPayment is the binding of the removed transferETHToPayment method.
+//
+// Deprecated: not supported by the current EnterpriseWallet contract; always returns ErrEnterpriseWalletMethodRemoved.
+func (_EnterpriseWallet *EnterpriseWalletSession) TransferETHToPayment(paymentAccount common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _EnterpriseWallet.Contract.TransferETHToPayment(&_EnterpriseWallet.TransactOpts, paymentAccount, amount)
+}
+
+// TransferETHToPayment is the binding of the removed transferETHToPayment method.
+//
+// Deprecated: not supported by the current EnterpriseWallet contract; always returns ErrEnterpriseWalletMethodRemoved.
+func (_EnterpriseWallet *EnterpriseWalletTransactorSession) TransferETHToPayment(paymentAccount common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _EnterpriseWallet.Contract.TransferETHToPayment(&_EnterpriseWallet.TransactOpts, paymentAccount, amount)
+}
+
+// EnterpriseWalletAllowanceSetIterator is returned from FilterAllowanceSet and is used to iterate over the raw logs and unpacked data for AllowanceSet events raised by the EnterpriseWallet contract.
+//
+// Deprecated: the current EnterpriseWallet contract does not emit AllowanceSet.
+type EnterpriseWalletAllowanceSetIterator struct {
+	Event *EnterpriseWalletAllowanceSet // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *EnterpriseWalletAllowanceSetIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(EnterpriseWalletAllowanceSet)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(EnterpriseWalletAllowanceSet)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *EnterpriseWalletAllowanceSetIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *EnterpriseWalletAllowanceSetIterator) Close() error {
+	if it.sub != nil {
+		it.sub.Unsubscribe()
+	}
+	return nil
+}
+
+// EnterpriseWalletAllowanceSet represents a AllowanceSet event raised by the EnterpriseWallet contract.
+//
+// Deprecated: the current EnterpriseWallet contract does not emit AllowanceSet.
+type EnterpriseWalletAllowanceSet struct {
+	PaymentAccount common.Address
+	Token          common.Address
+	Amount         *big.Int
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterAllowanceSet is the log retrieval binding of the removed AllowanceSet event.
+//
+// Deprecated: the current EnterpriseWallet contract does not emit AllowanceSet; always returns ErrEnterpriseWalletMethodRemoved.
+func (_EnterpriseWallet *EnterpriseWalletFilterer) FilterAllowanceSet(opts *bind.FilterOpts, paymentAccount []common.Address, token []common.Address) (*EnterpriseWalletAllowanceSetIterator, error) {
+	return nil, fmt.Errorf("AllowanceSet: %w", ErrEnterpriseWalletMethodRemoved)
+}
+
+// WatchAllowanceSet is the log subscription binding of the removed AllowanceSet event.
+//
+// Deprecated: the current EnterpriseWallet contract does not emit AllowanceSet; always returns ErrEnterpriseWalletMethodRemoved.
+func (_EnterpriseWallet *EnterpriseWalletFilterer) WatchAllowanceSet(opts *bind.WatchOpts, sink chan<- *EnterpriseWalletAllowanceSet, paymentAccount []common.Address, token []common.Address) (event.Subscription, error) {
+	return nil, fmt.Errorf("AllowanceSet: %w", ErrEnterpriseWalletMethodRemoved)
+}
+
+// ParseAllowanceSet is a log parse operation binding the contract event 0x35b43951b46e772259ca8b566c89beccb8d46513d2e0388b81504e7d27784f29.
+// It still decodes AllowanceSet logs emitted by wallets deployed before the event was removed.
+//
+// Solidity: event AllowanceSet(address indexed paymentAccount, address indexed token, uint256 amount)
+func (_EnterpriseWallet *EnterpriseWalletFilterer) ParseAllowanceSet(log types.Log) (*EnterpriseWalletAllowanceSet, error) {
+	parsed, err := abi.JSON(strings.NewReader(enterpriseWalletLegacyABI))
+	if err != nil {
+		return nil, err
+	}
+	event := new(EnterpriseWalletAllowanceSet)
+	if err := bind.NewBoundContract(log.Address, parsed, nil, nil, nil).UnpackLog(event, "AllowanceSet", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
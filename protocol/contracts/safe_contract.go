@@ -153,6 +153,17 @@ func (sc *SafeContract) GetFallbackHandler(ctx context.Context) (common.Address,
 	return common.BytesToAddress(storageValue), nil
 }
 
+// GetSingleton returns the singleton (master copy) the Safe proxy delegates to
+func (sc *SafeContract) GetSingleton(ctx context.Context) (common.Address, error) {
+	// The proxy keeps the singleton address in storage slot 0
+	storageValue, err := sc.client.StorageAt(ctx, sc.address, common.Hash{}, nil)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to read singleton from storage: %w", err)
+	}
+
+	return common.BytesToAddress(storageValue), nil
+}
+
 // ExecTransaction executes a Safe transaction
 func (sc *SafeContract) ExecTransaction(
 	ctx context.Context,
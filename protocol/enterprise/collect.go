@@ -0,0 +1,261 @@
+package enterprise
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/vikkkko/safe-core-sdk-golang/protocol/utils"
+)
+
+const defaultAccountPageSize = 100
+
+// balanceReader is implemented by backends able to read ETH balances, e.g. *ethclient.Client
+type balanceReader interface {
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+}
+
+// CollectOptions configures a batch collection plan
+type CollectOptions struct {
+	// Tokens to collect; the zero address stands for ETH
+	Tokens []common.Address
+	// MinAmounts skips balances below the per-token dust threshold (optional)
+	MinAmounts map[common.Address]*big.Int
+	// PageSize for getCollectionAccountsPaginated (default 100)
+	PageSize uint64
+}
+
+// CollectionBalance is the balance of one token in one collection account
+type CollectionBalance struct {
+	Account common.Address `json:"account"`
+	Token   common.Address `json:"token"`
+	Balance *big.Int       `json:"balance"`
+	Skipped bool           `json:"skipped"`
+	Reason  string         `json:"reason,omitempty"` // Why the balance is not collected
+}
+
+// CollectionBatch is one batchCollectFunds call for a token
+type CollectionBatch struct {
+	Token    common.Address   `json:"token"`
+	Accounts []common.Address `json:"accounts"`
+	Total    *big.Int         `json:"total"`
+	Call     Call             `json:"call"`
+}
+
+// CollectionPlan lists what a batch collection will move, for review before execution
+type CollectionPlan struct {
+	Wallet      common.Address      `json:"wallet"`
+	BlockNumber uint64              `json:"blockNumber"` // Block the balances were read at
+	Balances    []CollectionBalance `json:"balances"`
+	Batches     []CollectionBatch   `json:"batches"`
+}
+
+// IsEmpty reports whether there is nothing to collect
+func (p *CollectionPlan) IsEmpty() bool {
+	return len(p.Batches) == 0
+}
+
+// Calls returns the batchCollectFunds calls of the plan
+func (p *CollectionPlan) Calls() []Call {
+	calls := make([]Call, len(p.Batches))
+	for i, batch := range p.Batches {
+		calls[i] = batch.Call
+	}
+	return calls
+}
+
+// String returns a human readable summary of the plan
+func (p *CollectionPlan) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Collection plan for %s at block %d\n", p.Wallet.Hex(), p.BlockNumber)
+	for _, batch := range p.Batches {
+		fmt.Fprintf(&sb, "  token %s: %s from %d accounts\n", batch.Token.Hex(), batch.Total, len(batch.Accounts))
+	}
+	for _, balance := range p.Balances {
+		if balance.Skipped && balance.Balance != nil && balance.Balance.Sign() > 0 {
+			fmt.Fprintf(&sb, "  skipped %s of %s in %s: %s\n", balance.Balance, balance.Token.Hex(), balance.Account.Hex(), balance.Reason)
+		}
+	}
+	return sb.String()
+}
+
+// PlanBatchCollection reads the balances of every active collection account and
+// builds one batchCollectFunds call per token with something to collect
+func (c *EnterpriseWalletClient) PlanBatchCollection(ctx context.Context, opts CollectOptions) (*CollectionPlan, error) {
+	if len(opts.Tokens) == 0 {
+		return nil, fmt.Errorf("no tokens to collect")
+	}
+
+	header, err := c.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest block: %w", err)
+	}
+	// Read everything at one block so the plan is consistent
+	callOpts := &bind.CallOpts{Context: ctx, BlockNumber: header.Number}
+
+	accounts, err := c.listCollectionAccounts(callOpts, opts.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &CollectionPlan{
+		Wallet:      c.address,
+		BlockNumber: header.Number.Uint64(),
+	}
+	batches := make(map[common.Address]*CollectionBatch)
+
+	for _, account := range accounts {
+		frozen := false
+		if account.IsActive {
+			frozen, err = c.contract.IsFrozen(callOpts, account.Account)
+			if err != nil {
+				return nil, fmt.Errorf("failed to check frozen state of %s: %w", account.Account.Hex(), err)
+			}
+		}
+
+		for _, token := range opts.Tokens {
+			balance := CollectionBalance{Account: account.Account, Token: token}
+			switch {
+			case !account.IsActive:
+				balance.Skipped, balance.Reason = true, "account inactive"
+			case frozen:
+				balance.Skipped, balance.Reason = true, "account frozen"
+			}
+
+			if !balance.Skipped {
+				balance.Balance, err = c.tokenBalance(ctx, token, account.Account, header.Number)
+				if err != nil {
+					return nil, err
+				}
+				if balance.Balance.Sign() == 0 {
+					balance.Skipped, balance.Reason = true, "empty"
+				} else if min := opts.MinAmounts[token]; min != nil && balance.Balance.Cmp(min) < 0 {
+					balance.Skipped, balance.Reason = true, fmt.Sprintf("below dust threshold %s", min)
+				}
+			}
+			plan.Balances = append(plan.Balances, balance)
+
+			if balance.Skipped {
+				continue
+			}
+			batch, ok := batches[token]
+			if !ok {
+				batch = &CollectionBatch{Token: token, Total: new(big.Int)}
+				batches[token] = batch
+			}
+			batch.Accounts = append(batch.Accounts, account.Account)
+			batch.Total.Add(batch.Total, balance.Balance)
+		}
+	}
+
+	// Keep batches in the order tokens were requested
+	for _, token := range opts.Tokens {
+		batch, ok := batches[token]
+		if !ok {
+			continue
+		}
+		delete(batches, token)
+
+		data, err := utils.BatchCollectFundsData(token, batch.Accounts)
+		if err != nil {
+			return nil, err
+		}
+		batch.Call = Call{To: c.address, Value: big.NewInt(0), Data: data}
+		plan.Batches = append(plan.Batches, *batch)
+	}
+
+	return plan, nil
+}
+
+// ExecuteBatchCollection runs a reviewed plan. A SafeSender wraps all batches
+// into one MultiSend Safe transaction; other senders send one transaction per token.
+func (c *EnterpriseWalletClient) ExecuteBatchCollection(ctx context.Context, sender Sender, plan *CollectionPlan) ([]*CallResult, error) {
+	if plan.IsEmpty() {
+		return nil, fmt.Errorf("collection plan is empty")
+	}
+	if plan.Wallet != c.address {
+		return nil, fmt.Errorf("plan is for wallet %s, not %s", plan.Wallet.Hex(), c.address.Hex())
+	}
+
+	results, err := sendAll(ctx, sender, plan.Calls())
+	if err != nil {
+		return results, fmt.Errorf("failed to send batchCollectFunds: %w", err)
+	}
+	return results, nil
+}
+
+// listCollectionAccounts pages through getCollectionAccountsPaginated
+func (c *EnterpriseWalletClient) listCollectionAccounts(opts *bind.CallOpts, pageSize uint64) ([]AccountInfo, error) {
+	accounts, err := listAccounts(pageSize, func(offset, limit *big.Int) ([]AccountInfo, *big.Int, error) {
+		page, err := c.contract.GetCollectionAccountsPaginated(opts, offset, limit)
+		return page.Accounts, page.Total, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get collection accounts: %w", err)
+	}
+	return accounts, nil
+}
+
+// listPaymentAccounts pages through getPaymentAccountsPaginated
+func (c *EnterpriseWalletClient) listPaymentAccounts(opts *bind.CallOpts, pageSize uint64) ([]AccountInfo, error) {
+	accounts, err := listAccounts(pageSize, func(offset, limit *big.Int) ([]AccountInfo, *big.Int, error) {
+		page, err := c.contract.GetPaymentAccountsPaginated(opts, offset, limit)
+		return page.Accounts, page.Total, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get payment accounts: %w", err)
+	}
+	return accounts, nil
+}
+
+// listAccounts collects every page returned by fetch
+func listAccounts(pageSize uint64, fetch func(offset, limit *big.Int) ([]AccountInfo, *big.Int, error)) ([]AccountInfo, error) {
+	if pageSize == 0 {
+		pageSize = defaultAccountPageSize
+	}
+
+	var accounts []AccountInfo
+	for offset := uint64(0); ; offset += pageSize {
+		page, total, err := fetch(new(big.Int).SetUint64(offset), new(big.Int).SetUint64(pageSize))
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, page...)
+
+		if len(page) == 0 || total == nil || uint64(len(accounts)) >= total.Uint64() {
+			return accounts, nil
+		}
+	}
+}
+
+// tokenBalance returns the ETH (zero token) or ERC20 balance of account
+func (c *EnterpriseWalletClient) tokenBalance(ctx context.Context, token, account common.Address, block *big.Int) (*big.Int, error) {
+	if token == (common.Address{}) {
+		reader, ok := c.backend.(balanceReader)
+		if !ok {
+			return nil, fmt.Errorf("backend cannot read ETH balances")
+		}
+		balance, err := reader.BalanceAt(ctx, account, block)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get ETH balance of %s: %w", account.Hex(), err)
+		}
+		return balance, nil
+	}
+
+	data, err := utils.CreateERC20BalanceOfData(account.Hex())
+	if err != nil {
+		return nil, err
+	}
+	result, err := c.backend.CallContract(ctx, ethereum.CallMsg{To: &token, Data: data}, block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s balance of %s: %w", token.Hex(), account.Hex(), err)
+	}
+	if len(result) < 32 {
+		return nil, fmt.Errorf("invalid balanceOf result from %s", token.Hex())
+	}
+	return new(big.Int).SetBytes(result[:32]), nil
+}
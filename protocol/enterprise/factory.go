@@ -0,0 +1,108 @@
+package enterprise
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/vikkkko/safe-core-sdk-golang/protocol/contracts"
+	"github.com/vikkkko/safe-core-sdk-golang/protocol/utils"
+)
+
+// EnterpriseWalletFactoryClient is a typed client for an EnterpriseWalletFactory contract
+type EnterpriseWalletFactoryClient struct {
+	address  common.Address
+	backend  bind.ContractBackend
+	contract *contracts.EnterpriseWalletFactory
+	abi      *abi.ABI
+}
+
+// NewEnterpriseWalletFactoryClient creates a client for the factory at address
+func NewEnterpriseWalletFactoryClient(address common.Address, backend bind.ContractBackend) (*EnterpriseWalletFactoryClient, error) {
+	contract, err := contracts.NewEnterpriseWalletFactory(address, backend)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create EnterpriseWalletFactory contract instance: %w", err)
+	}
+
+	parsedABI, err := contracts.EnterpriseWalletFactoryMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get EnterpriseWalletFactory ABI: %w", err)
+	}
+
+	return &EnterpriseWalletFactoryClient{
+		address:  address,
+		backend:  backend,
+		contract: contract,
+		abi:      parsedABI,
+	}, nil
+}
+
+// Address returns the factory address
+func (c *EnterpriseWalletFactoryClient) Address() common.Address {
+	return c.address
+}
+
+// Contract returns the underlying contract binding
+func (c *EnterpriseWalletFactoryClient) Contract() *contracts.EnterpriseWalletFactory {
+	return c.contract
+}
+
+// IsImplementationWhitelisted checks if implementation may be used for new wallets
+func (c *EnterpriseWalletFactoryClient) IsImplementationWhitelisted(ctx context.Context, implementation common.Address) (bool, error) {
+	ok, err := c.contract.IsImplementationWhitelisted(&bind.CallOpts{Context: ctx}, implementation)
+	if err != nil {
+		return false, fmt.Errorf("failed to check implementation whitelist: %w", err)
+	}
+	return ok, nil
+}
+
+// GetWhitelistedImplementations returns all whitelisted implementations
+func (c *EnterpriseWalletFactoryClient) GetWhitelistedImplementations(ctx context.Context) ([]common.Address, error) {
+	implementations, err := c.contract.GetWhitelistedImplementations(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get whitelisted implementations: %w", err)
+	}
+	return implementations, nil
+}
+
+// PredictWalletAddress returns the address a wallet created by deployer with salt will get
+func (c *EnterpriseWalletFactoryClient) PredictWalletAddress(ctx context.Context, implementation common.Address, salt [32]byte, deployer common.Address) (common.Address, error) {
+	wallet, err := c.contract.PredictWalletAddress(&bind.CallOpts{Context: ctx}, implementation, salt, deployer)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to predict wallet address: %w", err)
+	}
+	return wallet, nil
+}
+
+// CreateWallet deploys a new enterprise wallet. The wallet address depends on
+// the sender, so predict it with sender.From() as deployer.
+func (c *EnterpriseWalletFactoryClient) CreateWallet(ctx context.Context, sender Sender, implementation common.Address, salt [32]byte, params utils.InitParams) (*CallResult, error) {
+	configs := make([]contracts.IEnterpriseWalletTypesMethodConfig, len(params.Configs))
+	for i, config := range params.Configs {
+		configs[i] = contracts.IEnterpriseWalletTypesMethodConfig{Controller: config.Controller}
+	}
+
+	data, err := c.abi.Pack("createWallet", implementation, salt, contracts.IEnterpriseWalletFactoryInitParams{
+		Methods:    params.Methods,
+		Configs:    configs,
+		SuperAdmin: params.SuperAdmin,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode createWallet call: %w", err)
+	}
+
+	result, err := sender.Send(ctx, Call{To: c.address, Value: big.NewInt(0), Data: data})
+	if err != nil {
+		return nil, fmt.Errorf("failed to send createWallet: %w", err)
+	}
+
+	return result, nil
+}
+
+// Wallet returns a client for a wallet deployed by this factory
+func (c *EnterpriseWalletFactoryClient) Wallet(address common.Address) (*EnterpriseWalletClient, error) {
+	return NewEnterpriseWalletClient(address, c.backend)
+}
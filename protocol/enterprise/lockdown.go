@@ -0,0 +1,148 @@
+package enterprise
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/vikkkko/safe-core-sdk-golang/protocol/utils"
+	"github.com/vikkkko/safe-core-sdk-golang/types"
+)
+
+// LockdownPlan lists the freeze/pause changes of a lockdown or unlock.
+// Accounts already in the target state are left out, so running it twice is a no-op.
+type LockdownPlan struct {
+	Wallet common.Address `json:"wallet"`
+	Lock   bool           `json:"lock"` // True for Lockdown, false for Unlock
+	// Accounts is every payment and collection account of the wallet
+	Accounts []common.Address `json:"accounts"`
+	// Targets are the accounts whose frozen state changes
+	Targets []common.Address `json:"targets"`
+	// TogglePause is set when the wallet pause state changes. A paused wallet is
+	// still unpaused around freeze calls within the batch.
+	TogglePause bool   `json:"togglePause"`
+	Calls       []Call `json:"calls"`
+	// Transaction is the single Safe transaction to sign, nil when nothing changes
+	Transaction *types.SafeTransaction `json:"transaction,omitempty"`
+}
+
+// IsEmpty reports whether the wallet is already in the target state
+func (p *LockdownPlan) IsEmpty() bool {
+	return len(p.Calls) == 0
+}
+
+// Lockdown freezes every payment and collection account and pauses the wallet
+// in one Safe transaction. The safe must be the controller of batchEmergencyFreeze
+// and emergencyPause.
+func Lockdown(ctx context.Context, wallet *EnterpriseWalletClient, safe *SafeSender) (*LockdownPlan, error) {
+	return planLockdown(ctx, wallet, safe, nil)
+}
+
+// Unlock reverses the lockdown plan returned by Lockdown: it unfreezes the accounts
+// the lockdown froze and unpauses the wallet if the lockdown paused it. Accounts
+// frozen and a pause set before the lockdown are kept.
+func Unlock(ctx context.Context, wallet *EnterpriseWalletClient, safe *SafeSender, lockdown *LockdownPlan) (*LockdownPlan, error) {
+	if lockdown == nil || !lockdown.Lock {
+		return nil, fmt.Errorf("unlock requires the plan returned by Lockdown")
+	}
+	if lockdown.Wallet != wallet.address {
+		return nil, fmt.Errorf("lockdown plan is for wallet %s, not %s", lockdown.Wallet.Hex(), wallet.address.Hex())
+	}
+	return planLockdown(ctx, wallet, safe, lockdown)
+}
+
+// planLockdown plans a lockdown, or the unlock of lockdown when it is not nil
+func planLockdown(ctx context.Context, wallet *EnterpriseWalletClient, safe *SafeSender, lockdown *LockdownPlan) (*LockdownPlan, error) {
+	lock := lockdown == nil
+
+	header, err := wallet.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest block: %w", err)
+	}
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: header.Number}
+
+	payment, err := wallet.listPaymentAccounts(opts, 0)
+	if err != nil {
+		return nil, err
+	}
+	collection, err := wallet.listCollectionAccounts(opts, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &LockdownPlan{
+		Wallet: wallet.address,
+		Lock:   lock,
+	}
+	for _, account := range append(payment, collection...) {
+		plan.Accounts = append(plan.Accounts, account.Account)
+	}
+
+	candidates := plan.Accounts
+	if !lock {
+		candidates = lockdown.Targets
+	}
+	for _, account := range candidates {
+		frozen, err := wallet.contract.IsFrozen(opts, account)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check frozen state of %s: %w", account.Hex(), err)
+		}
+		if frozen != lock {
+			plan.Targets = append(plan.Targets, account)
+		}
+	}
+
+	paused, err := wallet.contract.IsPaused(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check paused state: %w", err)
+	}
+	// An unlock keeps a pause the lockdown did not set
+	pause := lock || (paused && !lockdown.TogglePause)
+	plan.TogglePause = paused != pause
+
+	// Freeze calls never run against a paused wallet: a paused wallet is unpaused
+	// before them, and paused again after them when it must stay paused
+	if len(plan.Targets) > 0 {
+		if paused {
+			if err := plan.addPauseCall(false); err != nil {
+				return nil, err
+			}
+		}
+		data, err := utils.BatchEmergencyFreezeData(plan.Targets, lock)
+		if err != nil {
+			return nil, err
+		}
+		plan.Calls = append(plan.Calls, Call{To: wallet.address, Value: big.NewInt(0), Data: data})
+		if pause {
+			if err := plan.addPauseCall(true); err != nil {
+				return nil, err
+			}
+		}
+	} else if plan.TogglePause {
+		if err := plan.addPauseCall(pause); err != nil {
+			return nil, err
+		}
+	}
+	if plan.IsEmpty() {
+		return plan, nil
+	}
+
+	result, err := safe.SendBatch(ctx, plan.Calls)
+	if err != nil {
+		return nil, err
+	}
+	plan.Transaction = result.SafeTransaction
+
+	return plan, nil
+}
+
+func (p *LockdownPlan) addPauseCall(pause bool) error {
+	data, err := utils.EmergencyPauseData(pause)
+	if err != nil {
+		return err
+	}
+	p.Calls = append(p.Calls, Call{To: p.Wallet, Value: big.NewInt(0), Data: data})
+	return nil
+}
@@ -0,0 +1,162 @@
+package enterprise
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/vikkkko/safe-core-sdk-golang/protocol/contracts"
+)
+
+// PaymentExecutedEvent is emitted by a payment account for every transfer
+type PaymentExecutedEvent = contracts.PaymentAccountPaymentExecuted
+
+// EmergencyFrozenEvent is emitted when a payment account is frozen or unfrozen
+type EmergencyFrozenEvent = contracts.PaymentAccountEmergencyFrozen
+
+// PaymentAccountClient is a typed client for a PaymentAccount contract.
+// Writes must come from the account controller, usually a Safe, so they are
+// routed through a Sender such as SafeSender.
+type PaymentAccountClient struct {
+	address  common.Address
+	contract *contracts.PaymentAccount
+	abi      *abi.ABI
+}
+
+// NewPaymentAccountClient creates a client for the payment account at address
+func NewPaymentAccountClient(address common.Address, backend bind.ContractBackend) (*PaymentAccountClient, error) {
+	contract, err := contracts.NewPaymentAccount(address, backend)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create PaymentAccount contract instance: %w", err)
+	}
+
+	parsedABI, err := contracts.PaymentAccountMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get PaymentAccount ABI: %w", err)
+	}
+
+	return &PaymentAccountClient{
+		address:  address,
+		contract: contract,
+		abi:      parsedABI,
+	}, nil
+}
+
+// Address returns the payment account address
+func (c *PaymentAccountClient) Address() common.Address {
+	return c.address
+}
+
+// Contract returns the underlying contract binding
+func (c *PaymentAccountClient) Contract() *contracts.PaymentAccount {
+	return c.contract
+}
+
+// Name returns the account name
+func (c *PaymentAccountClient) Name(ctx context.Context) (string, error) {
+	name, err := c.contract.Name(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return "", fmt.Errorf("failed to get account name: %w", err)
+	}
+	return name, nil
+}
+
+// IsFrozen checks if the account is frozen
+func (c *PaymentAccountClient) IsFrozen(ctx context.Context) (bool, error) {
+	frozen, err := c.contract.IsFrozen(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return false, fmt.Errorf("failed to check frozen state: %w", err)
+	}
+	return frozen, nil
+}
+
+// EnterpriseWallet returns the enterprise wallet that owns the account
+func (c *PaymentAccountClient) EnterpriseWallet(ctx context.Context) (common.Address, error) {
+	wallet, err := c.contract.ENTERPRISEWALLET(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to get enterprise wallet: %w", err)
+	}
+	return wallet, nil
+}
+
+// Transfer moves amount of token (zero address for ETH) from `from` to `to`.
+// For ETH, from must be the payment account itself; for tokens it may be any
+// address that approved the payment account.
+func (c *PaymentAccountClient) Transfer(ctx context.Context, sender Sender, token, from, to common.Address, amount *big.Int) (*CallResult, error) {
+	if amount == nil || amount.Sign() <= 0 {
+		return nil, fmt.Errorf("amount must be positive")
+	}
+	return c.send(ctx, sender, "transfer", token, from, to, amount)
+}
+
+// Approve lets spender move amount of token held by the payment account
+func (c *PaymentAccountClient) Approve(ctx context.Context, sender Sender, token, spender common.Address, amount *big.Int) (*CallResult, error) {
+	if token == (common.Address{}) {
+		return nil, fmt.Errorf("cannot approve ETH")
+	}
+	if amount == nil {
+		amount = big.NewInt(0)
+	}
+	return c.send(ctx, sender, "approve", token, spender, amount)
+}
+
+// EmergencyFreeze freezes or unfreezes the account from its controller
+func (c *PaymentAccountClient) EmergencyFreeze(ctx context.Context, sender Sender, freeze bool) (*CallResult, error) {
+	return c.send(ctx, sender, "emergencyFreeze", freeze)
+}
+
+// WatchPaymentExecuted streams PaymentExecuted events. Nil filters match any value.
+func (c *PaymentAccountClient) WatchPaymentExecuted(ctx context.Context, sink chan<- *PaymentExecutedEvent, tokens, from, to []common.Address) (event.Subscription, error) {
+	sub, err := c.contract.WatchPaymentExecuted(&bind.WatchOpts{Context: ctx}, sink, tokens, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to watch PaymentExecuted events: %w", err)
+	}
+	return sub, nil
+}
+
+// WatchEmergencyFrozen streams EmergencyFrozen events of the account
+func (c *PaymentAccountClient) WatchEmergencyFrozen(ctx context.Context, sink chan<- *EmergencyFrozenEvent) (event.Subscription, error) {
+	sub, err := c.contract.WatchEmergencyFrozen(&bind.WatchOpts{Context: ctx}, sink, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to watch EmergencyFrozen events: %w", err)
+	}
+	return sub, nil
+}
+
+// FilterPaymentExecuted returns past PaymentExecuted events in the block range.
+// A nil end block means the latest block.
+func (c *PaymentAccountClient) FilterPaymentExecuted(ctx context.Context, start uint64, end *uint64, tokens, from, to []common.Address) ([]*PaymentExecutedEvent, error) {
+	it, err := c.contract.FilterPaymentExecuted(&bind.FilterOpts{Start: start, End: end, Context: ctx}, tokens, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to filter PaymentExecuted events: %w", err)
+	}
+	defer it.Close()
+
+	var events []*PaymentExecutedEvent
+	for it.Next() {
+		events = append(events, it.Event)
+	}
+	if err := it.Error(); err != nil {
+		return nil, fmt.Errorf("failed to iterate PaymentExecuted events: %w", err)
+	}
+	return events, nil
+}
+
+// send packs the payment account method call and hands it to sender
+func (c *PaymentAccountClient) send(ctx context.Context, sender Sender, method string, args ...interface{}) (*CallResult, error) {
+	data, err := c.abi.Pack(method, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s call: %w", method, err)
+	}
+
+	result, err := sender.Send(ctx, Call{To: c.address, Value: big.NewInt(0), Data: data})
+	if err != nil {
+		return nil, fmt.Errorf("failed to send %s: %w", method, err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,293 @@
+package enterprise
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/vikkkko/safe-core-sdk-golang/protocol/utils"
+)
+
+// ControllerKind classifies the account behind a method controller
+type ControllerKind string
+
+const (
+	ControllerUnset    ControllerKind = "unset"    // Zero address, nobody may call the method
+	ControllerEOA      ControllerKind = "eoa"      // Externally owned account
+	ControllerSafe     ControllerKind = "safe"     // Safe smart account
+	ControllerContract ControllerKind = "contract" // Any other contract
+)
+
+// getThresholdSelector is used to recognise Safe controllers
+var getThresholdSelector = common.FromHex("0xe75235b8")
+
+// MethodControllerPolicy maps wallet methods to their intended controller.
+// Keys may be a 4-byte selector ("0x1234abcd"), a full signature
+// ("createPaymentAccount(string,address)") or a method name ("createPaymentAccount").
+type MethodControllerPolicy map[string]common.Address
+
+// LoadMethodControllerPolicy reads a policy from a JSON file
+func LoadMethodControllerPolicy(path string) (MethodControllerPolicy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read policy file: %w", err)
+	}
+
+	var policy MethodControllerPolicy
+	if err := json.Unmarshal(data, &policy); err != nil {
+		return nil, fmt.Errorf("failed to parse policy file: %w", err)
+	}
+
+	return policy, nil
+}
+
+// MethodControllerStatus describes the controller of one wallet method
+type MethodControllerStatus struct {
+	Selector    [4]byte        `json:"selector"`
+	Method      string         `json:"method"` // Method signature
+	Current     common.Address `json:"current"`
+	Desired     common.Address `json:"desired"`
+	Kind        ControllerKind `json:"kind"`        // Kind of the desired controller
+	InPolicy    bool           `json:"inPolicy"`    // False when the policy does not mention the method
+	NeedsChange bool           `json:"needsChange"` // True when Current differs from Desired
+}
+
+// MethodControllerPlan is the result of diffing on-chain controllers against a policy
+type MethodControllerPlan struct {
+	Wallet     common.Address           `json:"wallet"`
+	SuperAdmin common.Address           `json:"superAdmin"`
+	Methods    []MethodControllerStatus `json:"methods"`
+	// Call is the single updateMethodControllers call, nil when nothing changes
+	Call *Call `json:"call,omitempty"`
+}
+
+// Changes returns the methods whose controller will be updated
+func (p *MethodControllerPlan) Changes() []MethodControllerStatus {
+	var changes []MethodControllerStatus
+	for _, method := range p.Methods {
+		if method.NeedsChange {
+			changes = append(changes, method)
+		}
+	}
+	return changes
+}
+
+// EOAControllers returns the methods that end up controlled by an EOA instead of a Safe
+func (p *MethodControllerPlan) EOAControllers() []MethodControllerStatus {
+	var eoas []MethodControllerStatus
+	for _, method := range p.Methods {
+		if method.Kind == ControllerEOA {
+			eoas = append(eoas, method)
+		}
+	}
+	return eoas
+}
+
+// String returns a human readable summary of the plan
+func (p *MethodControllerPlan) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Method controllers of %s (super admin %s)\n", p.Wallet.Hex(), p.SuperAdmin.Hex())
+	for _, method := range p.Methods {
+		marker := " "
+		if method.NeedsChange {
+			marker = "*"
+		}
+		fmt.Fprintf(&sb, "%s %s %s: %s -> %s (%s)\n", marker, hexutil.Encode(method.Selector[:]), method.Method, method.Current.Hex(), method.Desired.Hex(), method.Kind)
+	}
+	return sb.String()
+}
+
+// ResolveMethodControllerPolicy resolves policy keys to selectors of the wallet ABI
+func ResolveMethodControllerPolicy(walletABI *abi.ABI, policy MethodControllerPolicy) (map[[4]byte]common.Address, error) {
+	resolved := make(map[[4]byte]common.Address, len(policy))
+	for key, controller := range policy {
+		method, err := lookupMethod(walletABI, key)
+		if err != nil {
+			return nil, err
+		}
+
+		var selector [4]byte
+		copy(selector[:], method.ID)
+		if existing, ok := resolved[selector]; ok && existing != controller {
+			return nil, fmt.Errorf("conflicting controllers for %s", method.Sig)
+		}
+		resolved[selector] = controller
+	}
+	return resolved, nil
+}
+
+// PlanMethodControllers reads getMethodConfig for every state-changing method of
+// the wallet ABI and diffs it against policy. Methods absent from the policy
+// keep their current controller.
+func (c *EnterpriseWalletClient) PlanMethodControllers(ctx context.Context, policy MethodControllerPolicy) (*MethodControllerPlan, error) {
+	desired, err := ResolveMethodControllerPolicy(c.abi, policy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve policy: %w", err)
+	}
+
+	superAdmin, err := c.GetSuperAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &MethodControllerPlan{
+		Wallet:     c.address,
+		SuperAdmin: superAdmin,
+	}
+
+	kinds := make(map[common.Address]ControllerKind)
+	for _, method := range controllableMethods(c.abi) {
+		var selector [4]byte
+		copy(selector[:], method.ID)
+
+		config, err := c.contract.GetMethodConfig(&bind.CallOpts{Context: ctx}, selector)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get method config for %s: %w", method.Sig, err)
+		}
+
+		status := MethodControllerStatus{
+			Selector: selector,
+			Method:   method.Sig,
+			Current:  config.Controller,
+			Desired:  config.Controller,
+		}
+		if controller, ok := desired[selector]; ok {
+			status.Desired = controller
+			status.InPolicy = true
+			status.NeedsChange = controller != config.Controller
+		}
+
+		kind, ok := kinds[status.Desired]
+		if !ok {
+			kind, err = c.controllerKind(ctx, status.Desired)
+			if err != nil {
+				return nil, err
+			}
+			kinds[status.Desired] = kind
+		}
+		status.Kind = kind
+
+		plan.Methods = append(plan.Methods, status)
+	}
+
+	var selectors [][4]byte
+	var controllers []common.Address
+	for _, change := range plan.Changes() {
+		selectors = append(selectors, change.Selector)
+		controllers = append(controllers, change.Desired)
+	}
+	if len(selectors) > 0 {
+		data, err := utils.UpdateMethodControllersData(selectors, controllers)
+		if err != nil {
+			return nil, err
+		}
+		plan.Call = &Call{To: c.address, Data: data}
+	}
+
+	return plan, nil
+}
+
+// ApplyMethodControllerPlan sends the plan's updateMethodControllers call. The
+// sender must act for the super admin, normally a SafeSender for the admin Safe.
+func (c *EnterpriseWalletClient) ApplyMethodControllerPlan(ctx context.Context, sender Sender, plan *MethodControllerPlan) (*CallResult, error) {
+	if plan.Call == nil {
+		return nil, fmt.Errorf("method controller plan has no changes")
+	}
+	if sender.From() != plan.SuperAdmin {
+		return nil, fmt.Errorf("sender %s is not the super admin %s", sender.From().Hex(), plan.SuperAdmin.Hex())
+	}
+
+	result, err := sender.Send(ctx, *plan.Call)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send updateMethodControllers: %w", err)
+	}
+
+	return result, nil
+}
+
+// controllerKind classifies controller by its code and Safe interface
+func (c *EnterpriseWalletClient) controllerKind(ctx context.Context, controller common.Address) (ControllerKind, error) {
+	if controller == (common.Address{}) {
+		return ControllerUnset, nil
+	}
+
+	code, err := c.backend.CodeAt(ctx, controller, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to get code of %s: %w", controller.Hex(), err)
+	}
+	if len(code) == 0 {
+		return ControllerEOA, nil
+	}
+
+	result, err := c.backend.CallContract(ctx, ethereum.CallMsg{To: &controller, Data: getThresholdSelector}, nil)
+	if err == nil && len(result) == 32 && !bytes.Equal(result, make([]byte, 32)) {
+		return ControllerSafe, nil
+	}
+	return ControllerContract, nil
+}
+
+// controllableMethods returns the state-changing wallet methods sorted by signature
+func controllableMethods(walletABI *abi.ABI) []abi.Method {
+	var methods []abi.Method
+	for _, method := range walletABI.Methods {
+		if method.IsConstant() || method.Name == "initialize" {
+			continue
+		}
+		methods = append(methods, method)
+	}
+	sort.Slice(methods, func(i, j int) bool {
+		return methods[i].Sig < methods[j].Sig
+	})
+	return methods
+}
+
+// lookupMethod finds a method by selector, signature or name. A name shared by
+// overloaded methods is rejected as ambiguous.
+func lookupMethod(walletABI *abi.ABI, key string) (abi.Method, error) {
+	key = strings.TrimSpace(key)
+	if strings.HasPrefix(key, "0x") && len(key) == 10 {
+		method, err := walletABI.MethodById(common.FromHex(key))
+		if err != nil {
+			return abi.Method{}, fmt.Errorf("unknown selector %s: %w", key, err)
+		}
+		return *method, nil
+	}
+
+	var overloads []abi.Method
+	for _, method := range walletABI.Methods {
+		if method.Sig == key {
+			return method, nil
+		}
+		if method.RawName == key {
+			overloads = append(overloads, method)
+		}
+	}
+
+	switch len(overloads) {
+	case 0:
+	case 1:
+		return overloads[0], nil
+	default:
+		signatures := make([]string, 0, len(overloads))
+		for _, method := range overloads {
+			signatures = append(signatures, method.Sig)
+		}
+		sort.Strings(signatures)
+		return abi.Method{}, fmt.Errorf("method %s is overloaded, use a signature or selector: %s", key, strings.Join(signatures, ", "))
+	}
+
+	// Generated names of overloads, such as transfer0
+	if method, ok := walletABI.Methods[key]; ok {
+		return method, nil
+	}
+	return abi.Method{}, fmt.Errorf("unknown method %s", key)
+}
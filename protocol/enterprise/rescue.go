@@ -0,0 +1,216 @@
+package enterprise
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/vikkkko/safe-core-sdk-golang/protocol/contracts"
+	"github.com/vikkkko/safe-core-sdk-golang/protocol/utils"
+)
+
+// FundsRescuedEvent is emitted by rescueFunds
+type FundsRescuedEvent = contracts.EnterpriseWalletFundsRescued
+
+// StrandedBalance is a balance held by the wallet contract itself
+type StrandedBalance struct {
+	Token   common.Address `json:"token"` // Zero address for ETH
+	Balance *big.Int       `json:"balance"`
+}
+
+// RescuePlan moves stranded balances off the wallet contract. rescueFunds always
+// pays the super admin; when Recipient differs, each rescue is followed by a
+// transfer from the super admin to Recipient.
+type RescuePlan struct {
+	Wallet      common.Address    `json:"wallet"`
+	SuperAdmin  common.Address    `json:"superAdmin"`
+	Recipient   common.Address    `json:"recipient"`
+	BlockNumber uint64            `json:"blockNumber"` // Block the balances were read at
+	Balances    []StrandedBalance `json:"balances"`
+	Calls       []Call            `json:"calls"`
+}
+
+// IsEmpty reports whether there is nothing to rescue
+func (p *RescuePlan) IsEmpty() bool {
+	return len(p.Balances) == 0
+}
+
+// Forwards reports whether the rescued funds are forwarded from the super admin to Recipient
+func (p *RescuePlan) Forwards() bool {
+	return p.Recipient != p.SuperAdmin
+}
+
+// String returns a human readable summary of the plan
+func (p *RescuePlan) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Rescue plan for %s at block %d\n", p.Wallet.Hex(), p.BlockNumber)
+	for _, balance := range p.Balances {
+		fmt.Fprintf(&sb, "  token %s: %s\n", balance.Token.Hex(), balance.Balance)
+	}
+	if p.Forwards() {
+		fmt.Fprintf(&sb, "  forwarded from super admin %s to %s\n", p.SuperAdmin.Hex(), p.Recipient.Hex())
+	} else {
+		fmt.Fprintf(&sb, "  paid to super admin %s\n", p.SuperAdmin.Hex())
+	}
+	return sb.String()
+}
+
+// FindStrandedFunds returns the non-zero ETH and token balances held by the wallet contract
+func (c *EnterpriseWalletClient) FindStrandedFunds(ctx context.Context, tokens []common.Address) ([]StrandedBalance, error) {
+	header, err := c.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest block: %w", err)
+	}
+	return c.findStrandedFunds(ctx, tokens, header.Number)
+}
+
+// PlanRescue finds the stranded balances and builds the calls moving them to recipient.
+// A zero recipient keeps the funds with the super admin.
+func (c *EnterpriseWalletClient) PlanRescue(ctx context.Context, tokens []common.Address, recipient common.Address) (*RescuePlan, error) {
+	header, err := c.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest block: %w", err)
+	}
+
+	superAdmin, err := c.contract.GetSuperAdmin(&bind.CallOpts{Context: ctx, BlockNumber: header.Number})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get super admin: %w", err)
+	}
+	if recipient == (common.Address{}) {
+		recipient = superAdmin
+	}
+
+	balances, err := c.findStrandedFunds(ctx, tokens, header.Number)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &RescuePlan{
+		Wallet:      c.address,
+		SuperAdmin:  superAdmin,
+		Recipient:   recipient,
+		BlockNumber: header.Number.Uint64(),
+		Balances:    balances,
+	}
+	for _, balance := range balances {
+		data, err := utils.RescueFundsData(balance.Token, balance.Balance)
+		if err != nil {
+			return nil, err
+		}
+		plan.Calls = append(plan.Calls, Call{To: c.address, Value: big.NewInt(0), Data: data})
+
+		if !plan.Forwards() {
+			continue
+		}
+		forward, err := transferCall(balance.Token, recipient, balance.Balance)
+		if err != nil {
+			return nil, err
+		}
+		plan.Calls = append(plan.Calls, forward)
+	}
+
+	return plan, nil
+}
+
+// ExecuteRescue runs a reviewed plan. The sender must be the super admin, and
+// forwarding to another recipient needs a BatchSender so that the rescue and
+// the transfer happen atomically.
+func (c *EnterpriseWalletClient) ExecuteRescue(ctx context.Context, sender Sender, plan *RescuePlan) ([]*CallResult, error) {
+	if plan.IsEmpty() {
+		return nil, fmt.Errorf("rescue plan is empty")
+	}
+	if plan.Wallet != c.address {
+		return nil, fmt.Errorf("plan is for wallet %s, not %s", plan.Wallet.Hex(), c.address.Hex())
+	}
+	if sender.From() != plan.SuperAdmin {
+		return nil, fmt.Errorf("sender %s is not the super admin %s", sender.From().Hex(), plan.SuperAdmin.Hex())
+	}
+	if _, ok := sender.(BatchSender); plan.Forwards() && !ok {
+		return nil, fmt.Errorf("forwarding rescued funds to %s requires a Safe super admin", plan.Recipient.Hex())
+	}
+
+	results, err := sendAll(ctx, sender, plan.Calls)
+	if err != nil {
+		return results, fmt.Errorf("failed to send rescueFunds: %w", err)
+	}
+	return results, nil
+}
+
+// VerifyRescue checks that the receipts of the executed plan hold FundsRescued
+// events paying the super admin each balance of the plan, and returns the events found
+func (c *EnterpriseWalletClient) VerifyRescue(plan *RescuePlan, receipts ...*gethtypes.Receipt) ([]*FundsRescuedEvent, error) {
+	eventID := c.abi.Events["FundsRescued"].ID
+	rescued := make(map[common.Address]*big.Int)
+	var events []*FundsRescuedEvent
+	for _, receipt := range receipts {
+		if receipt.Status != gethtypes.ReceiptStatusSuccessful {
+			return nil, fmt.Errorf("rescue transaction %s failed", receipt.TxHash.Hex())
+		}
+
+		for _, log := range receipt.Logs {
+			if log.Address != c.address || len(log.Topics) == 0 || log.Topics[0] != eventID {
+				continue
+			}
+			event, err := c.contract.ParseFundsRescued(*log)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse FundsRescued event: %w", err)
+			}
+			events = append(events, event)
+
+			if event.To != plan.SuperAdmin {
+				continue
+			}
+			if rescued[event.Token] == nil {
+				rescued[event.Token] = new(big.Int)
+			}
+			rescued[event.Token].Add(rescued[event.Token], event.Amount)
+		}
+	}
+
+	for _, balance := range plan.Balances {
+		amount := rescued[balance.Token]
+		if amount == nil || amount.Cmp(balance.Balance) != 0 {
+			return events, fmt.Errorf("expected %s of %s to be rescued, got %v", balance.Balance, balance.Token.Hex(), amount)
+		}
+	}
+
+	return events, nil
+}
+
+// findStrandedFunds reads the ETH balance and each token balance of the wallet at block
+func (c *EnterpriseWalletClient) findStrandedFunds(ctx context.Context, tokens []common.Address, block *big.Int) ([]StrandedBalance, error) {
+	var balances []StrandedBalance
+	seen := make(map[common.Address]bool)
+	for _, token := range append([]common.Address{{}}, tokens...) {
+		if seen[token] {
+			continue
+		}
+		seen[token] = true
+
+		balance, err := c.tokenBalance(ctx, token, c.address, block)
+		if err != nil {
+			return nil, err
+		}
+		if balance.Sign() > 0 {
+			balances = append(balances, StrandedBalance{Token: token, Balance: balance})
+		}
+	}
+	return balances, nil
+}
+
+// transferCall sends amount of ETH (zero token) or an ERC20 token to recipient
+func transferCall(token, recipient common.Address, amount *big.Int) (Call, error) {
+	if token == (common.Address{}) {
+		return Call{To: recipient, Value: amount}, nil
+	}
+
+	data, err := utils.CreateERC20TransferData(recipient.Hex(), amount)
+	if err != nil {
+		return Call{}, err
+	}
+	return Call{To: token, Value: big.NewInt(0), Data: data}, nil
+}
@@ -0,0 +1,167 @@
+package enterprise
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/vikkkko/safe-core-sdk-golang/protocol"
+	"github.com/vikkkko/safe-core-sdk-golang/types"
+)
+
+// Call is a contract call to be run by a method controller
+type Call struct {
+	To    common.Address
+	Value *big.Int
+	Data  []byte
+}
+
+// CallResult holds the outcome of a write. Exactly one of the fields is set
+// depending on the sender that handled the call.
+type CallResult struct {
+	Transaction     *gethtypes.Transaction // Sent transaction when the controller is an EOA
+	SafeTransaction *types.SafeTransaction // Unsigned Safe transaction when the controller is a Safe
+}
+
+// Sender runs a call on behalf of the controller of the target method
+type Sender interface {
+	// From returns the address the call will originate from
+	From() common.Address
+	Send(ctx context.Context, call Call) (*CallResult, error)
+}
+
+// EOASender sends calls directly from an externally owned account
+type EOASender struct {
+	backend bind.ContractBackend
+	opts    *bind.TransactOpts
+}
+
+// NewEOASender creates a sender that signs and broadcasts calls with opts
+func NewEOASender(backend bind.ContractBackend, opts *bind.TransactOpts) *EOASender {
+	return &EOASender{
+		backend: backend,
+		opts:    opts,
+	}
+}
+
+// From returns the EOA address
+func (s *EOASender) From() common.Address {
+	return s.opts.From
+}
+
+// Send signs and broadcasts the call
+func (s *EOASender) Send(ctx context.Context, call Call) (*CallResult, error) {
+	opts := *s.opts
+	opts.Context = ctx
+	opts.Value = call.Value
+
+	contract := bind.NewBoundContract(call.To, abi.ABI{}, s.backend, s.backend, s.backend)
+	tx, err := contract.RawTransact(&opts, call.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send transaction: %w", err)
+	}
+
+	return &CallResult{Transaction: tx}, nil
+}
+
+// SafeSender wraps calls into Safe transactions for a Safe controller. The
+// returned transactions still need to be signed and executed or proposed.
+type SafeSender struct {
+	safe  *protocol.Safe
+	nonce *uint64
+}
+
+// NewSafeSender creates a sender producing transactions for the given Safe
+func NewSafeSender(safe *protocol.Safe) *SafeSender {
+	return &SafeSender{safe: safe}
+}
+
+// WithNonce returns a copy of the sender that uses a fixed Safe nonce, e.g. to
+// queue a transaction after ones already pending in the transaction service
+func (s *SafeSender) WithNonce(nonce uint64) *SafeSender {
+	return &SafeSender{
+		safe:  s.safe,
+		nonce: &nonce,
+	}
+}
+
+// From returns the Safe address
+func (s *SafeSender) From() common.Address {
+	return s.safe.GetAddress()
+}
+
+// Send creates the Safe transaction for the call
+func (s *SafeSender) Send(ctx context.Context, call Call) (*CallResult, error) {
+	value := "0"
+	if call.Value != nil {
+		value = call.Value.String()
+	}
+
+	tx, err := s.safe.CreateTransaction(ctx, types.SafeTransactionDataPartial{
+		To:    call.To.Hex(),
+		Value: value,
+		Data:  hexutil.Encode(call.Data),
+		Nonce: s.nonce,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Safe transaction: %w", err)
+	}
+
+	return &CallResult{SafeTransaction: tx}, nil
+}
+
+// SendBatch creates one Safe transaction running all calls, batched through
+// MultiSendCallOnly when there is more than one
+func (s *SafeSender) SendBatch(ctx context.Context, calls []Call) (*CallResult, error) {
+	txs := make([]types.MetaTransactionData, len(calls))
+	for i, call := range calls {
+		value := "0"
+		if call.Value != nil {
+			value = call.Value.String()
+		}
+		txs[i] = types.MetaTransactionData{
+			To:    call.To.Hex(),
+			Value: value,
+			Data:  hexutil.Encode(call.Data),
+		}
+	}
+
+	tx, err := s.safe.CreateMultiSendTransaction(ctx, txs, s.nonce)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Safe transaction: %w", err)
+	}
+
+	return &CallResult{SafeTransaction: tx}, nil
+}
+
+// BatchSender is a Sender able to run several calls atomically
+type BatchSender interface {
+	Sender
+	SendBatch(ctx context.Context, calls []Call) (*CallResult, error)
+}
+
+// sendAll runs calls atomically when sender supports batching and one by one otherwise
+func sendAll(ctx context.Context, sender Sender, calls []Call) ([]*CallResult, error) {
+	if batcher, ok := sender.(BatchSender); ok {
+		result, err := batcher.SendBatch(ctx, calls)
+		if err != nil {
+			return nil, err
+		}
+		return []*CallResult{result}, nil
+	}
+
+	results := make([]*CallResult, 0, len(calls))
+	for i, call := range calls {
+		result, err := sender.Send(ctx, call)
+		if err != nil {
+			return results, fmt.Errorf("failed to send call %d of %d: %w", i+1, len(calls), err)
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
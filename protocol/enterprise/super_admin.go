@@ -0,0 +1,377 @@
+package enterprise
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/vikkkko/safe-core-sdk-golang/protocol/contracts"
+	"github.com/vikkkko/safe-core-sdk-golang/protocol/utils"
+)
+
+const (
+	defaultTransferWarnBefore   = time.Hour
+	defaultTransferPollInterval = time.Minute
+)
+
+// SuperAdminTransferState is the lifecycle state of a super admin transfer
+type SuperAdminTransferState string
+
+const (
+	SuperAdminTransferNone     SuperAdminTransferState = "none"     // No active proposal
+	SuperAdminTransferPending  SuperAdminTransferState = "pending"  // Proposal can be confirmed
+	SuperAdminTransferExpiring SuperAdminTransferState = "expiring" // Proposal can be confirmed but expires soon
+	SuperAdminTransferExpired  SuperAdminTransferState = "expired"  // Proposal can no longer be confirmed
+)
+
+// SuperAdminTransferStatus describes a super admin transfer at a point in chain time
+type SuperAdminTransferStatus struct {
+	Transfer  SuperAdminTransfer      `json:"transfer"`
+	Valid     bool                    `json:"valid"` // Result of isValidSuperAdminTransfer
+	State     SuperAdminTransferState `json:"state"`
+	ExpiresAt time.Time               `json:"expiresAt"`
+	Remaining time.Duration           `json:"remaining"` // Time left to confirm, zero once expired
+}
+
+// CanConfirm reports whether the proposed super admin may confirm now
+func (s SuperAdminTransferStatus) CanConfirm() bool {
+	return s.State == SuperAdminTransferPending || s.State == SuperAdminTransferExpiring
+}
+
+// EvaluateSuperAdminTransfer computes the status of transfer at chain time now.
+// valid is the on-chain isValidSuperAdminTransfer result, which takes precedence
+// over the locally computed expiry.
+func EvaluateSuperAdminTransfer(transfer SuperAdminTransfer, valid bool, now time.Time, warnBefore time.Duration) SuperAdminTransferStatus {
+	status := SuperAdminTransferStatus{
+		Transfer: transfer,
+		Valid:    valid,
+		State:    SuperAdminTransferNone,
+	}
+	if !transfer.IsActive {
+		return status
+	}
+
+	if transfer.ProposedAt != nil && transfer.Timeout != nil {
+		expiry := new(big.Int).Add(transfer.ProposedAt, transfer.Timeout)
+		status.ExpiresAt = time.Unix(expiry.Int64(), 0)
+		if remaining := status.ExpiresAt.Sub(now); remaining > 0 {
+			status.Remaining = remaining
+		}
+	}
+
+	switch {
+	case !valid || status.Remaining == 0:
+		status.State = SuperAdminTransferExpired
+		status.Remaining = 0
+	case status.Remaining <= warnBefore:
+		status.State = SuperAdminTransferExpiring
+	default:
+		status.State = SuperAdminTransferPending
+	}
+	return status
+}
+
+// SuperAdminTransferManager drives the propose/confirm/cancel super admin
+// transfer workflow of an enterprise wallet
+type SuperAdminTransferManager struct {
+	wallet     *EnterpriseWalletClient
+	warnBefore time.Duration
+}
+
+// NewSuperAdminTransferManager creates a manager for the wallet's super admin transfers
+func NewSuperAdminTransferManager(wallet *EnterpriseWalletClient) *SuperAdminTransferManager {
+	return &SuperAdminTransferManager{
+		wallet:     wallet,
+		warnBefore: defaultTransferWarnBefore,
+	}
+}
+
+// SetWarnBefore sets how long before expiry a proposal is reported as expiring
+func (m *SuperAdminTransferManager) SetWarnBefore(warnBefore time.Duration) {
+	m.warnBefore = warnBefore
+}
+
+// Status reads the transfer and evaluates it against the latest block time
+func (m *SuperAdminTransferManager) Status(ctx context.Context) (*SuperAdminTransferStatus, error) {
+	opts := &bind.CallOpts{Context: ctx}
+
+	transfer, err := m.wallet.contract.GetSuperAdminTransfer(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get super admin transfer: %w", err)
+	}
+
+	valid, err := m.wallet.contract.IsValidSuperAdminTransfer(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check super admin transfer: %w", err)
+	}
+
+	header, err := m.wallet.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest block: %w", err)
+	}
+
+	status := EvaluateSuperAdminTransfer(transfer, valid, time.Unix(int64(header.Time), 0), m.warnBefore)
+	return &status, nil
+}
+
+// Propose proposes newSuperAdmin, who must confirm within timeout. The sender
+// must act for the current super admin.
+func (m *SuperAdminTransferManager) Propose(ctx context.Context, sender Sender, newSuperAdmin common.Address, timeout time.Duration) (*CallResult, error) {
+	if newSuperAdmin == (common.Address{}) {
+		return nil, fmt.Errorf("new super admin cannot be the zero address")
+	}
+	if timeout < time.Second {
+		return nil, fmt.Errorf("timeout must be at least one second")
+	}
+
+	superAdmin, err := m.wallet.GetSuperAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if sender.From() != superAdmin {
+		return nil, fmt.Errorf("sender %s is not the super admin %s", sender.From().Hex(), superAdmin.Hex())
+	}
+	if newSuperAdmin == superAdmin {
+		return nil, fmt.Errorf("%s is already the super admin", newSuperAdmin.Hex())
+	}
+
+	status, err := m.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if status.CanConfirm() {
+		return nil, fmt.Errorf("a transfer to %s is already pending until %s", status.Transfer.ProposedSuperAdmin.Hex(), status.ExpiresAt.UTC().Format(time.RFC3339))
+	}
+
+	data, err := utils.ProposeSuperAdminTransferData(newSuperAdmin, big.NewInt(int64(timeout/time.Second)))
+	if err != nil {
+		return nil, err
+	}
+	return m.sendCall(ctx, sender, "proposeSuperAdminTransfer", data)
+}
+
+// Confirm confirms the pending transfer. It refuses expired proposals and
+// senders other than the proposed super admin.
+func (m *SuperAdminTransferManager) Confirm(ctx context.Context, sender Sender) (*CallResult, error) {
+	status, err := m.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	switch status.State {
+	case SuperAdminTransferNone:
+		return nil, fmt.Errorf("no super admin transfer is pending")
+	case SuperAdminTransferExpired:
+		return nil, fmt.Errorf("super admin transfer to %s expired at %s", status.Transfer.ProposedSuperAdmin.Hex(), status.ExpiresAt.UTC().Format(time.RFC3339))
+	}
+	if sender.From() != status.Transfer.ProposedSuperAdmin {
+		return nil, fmt.Errorf("sender %s is not the proposed super admin %s", sender.From().Hex(), status.Transfer.ProposedSuperAdmin.Hex())
+	}
+
+	data, err := utils.ConfirmSuperAdminTransferData()
+	if err != nil {
+		return nil, err
+	}
+	return m.sendCall(ctx, sender, "confirmSuperAdminTransfer", data)
+}
+
+// Cancel cancels the pending transfer. The sender must act for the current super admin.
+func (m *SuperAdminTransferManager) Cancel(ctx context.Context, sender Sender) (*CallResult, error) {
+	status, err := m.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if status.State == SuperAdminTransferNone {
+		return nil, fmt.Errorf("no super admin transfer is pending")
+	}
+	if sender.From() != status.Transfer.CurrentSuperAdmin {
+		return nil, fmt.Errorf("sender %s is not the super admin %s", sender.From().Hex(), status.Transfer.CurrentSuperAdmin.Hex())
+	}
+
+	data, err := utils.CancelSuperAdminTransferData()
+	if err != nil {
+		return nil, err
+	}
+	return m.sendCall(ctx, sender, "cancelSuperAdminTransfer", data)
+}
+
+func (m *SuperAdminTransferManager) sendCall(ctx context.Context, sender Sender, method string, data []byte) (*CallResult, error) {
+	result, err := sender.Send(ctx, Call{To: m.wallet.address, Value: big.NewInt(0), Data: data})
+	if err != nil {
+		return nil, fmt.Errorf("failed to send %s: %w", method, err)
+	}
+	return result, nil
+}
+
+// SuperAdminTransferUpdate is delivered when the transfer state changes
+type SuperAdminTransferUpdate struct {
+	// Event is the contract event that triggered the update, e.g.
+	// "SuperAdminTransferProposed", or empty for time based updates
+	Event  string                   `json:"event,omitempty"`
+	Status SuperAdminTransferStatus `json:"status"`
+}
+
+// SuperAdminTransferWatch streams super admin transfer updates
+type SuperAdminTransferWatch struct {
+	updates chan *SuperAdminTransferUpdate
+	errs    chan error
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+// Updates returns the update channel. It is closed when the watch ends.
+func (w *SuperAdminTransferWatch) Updates() <-chan *SuperAdminTransferUpdate {
+	return w.updates
+}
+
+// Err returns non-fatal errors such as failed status reads. It is closed together
+// with the update channel.
+func (w *SuperAdminTransferWatch) Err() <-chan error {
+	return w.errs
+}
+
+// Stop ends the watch and waits for it to shut down
+func (w *SuperAdminTransferWatch) Stop() {
+	w.cancel()
+	<-w.done
+}
+
+// Watch delivers an update on every SuperAdminTransfer* event and whenever the
+// state changes with time, i.e. when the proposal starts expiring or expires.
+// The status is also re-read every pollInterval (default one minute), which
+// keeps the watch working on RPCs without log subscriptions.
+func (m *SuperAdminTransferManager) Watch(ctx context.Context, pollInterval time.Duration) (*SuperAdminTransferWatch, error) {
+	if pollInterval <= 0 {
+		pollInterval = defaultTransferPollInterval
+	}
+
+	status, err := m.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	watchCtx, cancel := context.WithCancel(ctx)
+	w := &SuperAdminTransferWatch{
+		updates: make(chan *SuperAdminTransferUpdate, 16),
+		errs:    make(chan error, 16),
+		cancel:  cancel,
+		done:    make(chan struct{}),
+	}
+
+	events := make(chan string, 16)
+	subs := m.subscribeTransferEvents(watchCtx, events, w)
+
+	go func() {
+		defer close(w.done)
+		defer close(w.updates)
+		defer close(w.errs)
+		defer func() {
+			for _, sub := range subs {
+				sub.Unsubscribe()
+			}
+		}()
+
+		w.deliver(watchCtx, &SuperAdminTransferUpdate{Status: *status})
+		last := status.State
+
+		ticker := time.NewTicker(pollInterval)
+		defer ticker.Stop()
+
+		for {
+			var eventName string
+			select {
+			case <-watchCtx.Done():
+				return
+			case eventName = <-events:
+			case <-ticker.C:
+			}
+
+			current, err := m.Status(watchCtx)
+			if err != nil {
+				w.report(err)
+				continue
+			}
+			if eventName == "" && current.State == last {
+				continue
+			}
+			last = current.State
+			if !w.deliver(watchCtx, &SuperAdminTransferUpdate{Event: eventName, Status: *current}) {
+				return
+			}
+		}
+	}()
+
+	return w, nil
+}
+
+// subscribeTransferEvents forwards the names of SuperAdminTransfer* events to
+// events. Subscription failures are reported and leave the watch polling.
+func (m *SuperAdminTransferManager) subscribeTransferEvents(ctx context.Context, events chan<- string, w *SuperAdminTransferWatch) []event.Subscription {
+	opts := &bind.WatchOpts{Context: ctx}
+	filterer := m.wallet.contract.EnterpriseWalletFilterer
+	var subs []event.Subscription
+
+	proposed := make(chan *contracts.EnterpriseWalletSuperAdminTransferProposed)
+	if sub, err := filterer.WatchSuperAdminTransferProposed(opts, proposed, nil, nil); err != nil {
+		w.report(fmt.Errorf("failed to watch SuperAdminTransferProposed events: %w", err))
+	} else {
+		subs = append(subs, sub)
+		go forwardTransferEvent(ctx, sub, proposed, events, "SuperAdminTransferProposed")
+	}
+
+	cancelled := make(chan *contracts.EnterpriseWalletSuperAdminTransferCancelled)
+	if sub, err := filterer.WatchSuperAdminTransferCancelled(opts, cancelled); err != nil {
+		w.report(fmt.Errorf("failed to watch SuperAdminTransferCancelled events: %w", err))
+	} else {
+		subs = append(subs, sub)
+		go forwardTransferEvent(ctx, sub, cancelled, events, "SuperAdminTransferCancelled")
+	}
+
+	transferred := make(chan *contracts.EnterpriseWalletSuperAdminTransferred)
+	if sub, err := filterer.WatchSuperAdminTransferred(opts, transferred, nil, nil); err != nil {
+		w.report(fmt.Errorf("failed to watch SuperAdminTransferred events: %w", err))
+	} else {
+		subs = append(subs, sub)
+		go forwardTransferEvent(ctx, sub, transferred, events, "SuperAdminTransferred")
+	}
+
+	return subs
+}
+
+func forwardTransferEvent[T any](ctx context.Context, sub event.Subscription, sink <-chan T, events chan<- string, name string) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-sub.Err():
+			return
+		case <-sink:
+			select {
+			case events <- name:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+func (w *SuperAdminTransferWatch) deliver(ctx context.Context, update *SuperAdminTransferUpdate) bool {
+	select {
+	case w.updates <- update:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// report forwards a non-fatal error without blocking the watch
+func (w *SuperAdminTransferWatch) report(err error) {
+	select {
+	case w.errs <- err:
+	default:
+	}
+}
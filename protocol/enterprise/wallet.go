@@ -0,0 +1,331 @@
+package enterprise
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/vikkkko/safe-core-sdk-golang/protocol/contracts"
+	"github.com/vikkkko/safe-core-sdk-golang/protocol/utils"
+)
+
+// AccountInfo describes a payment or collection account of an enterprise wallet
+type AccountInfo = contracts.IAccountManagementAccountInfo
+
+// SuperAdminTransfer describes the pending super admin transfer of an enterprise wallet
+type SuperAdminTransfer = contracts.ISuperAdminManagementSuperAdminTransfer
+
+// EnterpriseWalletClient is a typed client for an EnterpriseWallet contract.
+// Reads go through the contract binding; writes are routed through a Sender so
+// that they run from an EOA or become Safe transactions for Safe controllers.
+type EnterpriseWalletClient struct {
+	address  common.Address
+	backend  bind.ContractBackend
+	contract *contracts.EnterpriseWallet
+	abi      *abi.ABI
+}
+
+// NewEnterpriseWalletClient creates a client for the wallet at address
+func NewEnterpriseWalletClient(address common.Address, backend bind.ContractBackend) (*EnterpriseWalletClient, error) {
+	contract, err := contracts.NewEnterpriseWallet(address, backend)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create EnterpriseWallet contract instance: %w", err)
+	}
+
+	parsedABI, err := contracts.EnterpriseWalletMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get EnterpriseWallet ABI: %w", err)
+	}
+
+	return &EnterpriseWalletClient{
+		address:  address,
+		backend:  backend,
+		contract: contract,
+		abi:      parsedABI,
+	}, nil
+}
+
+// Address returns the wallet address
+func (c *EnterpriseWalletClient) Address() common.Address {
+	return c.address
+}
+
+// Contract returns the underlying contract binding
+func (c *EnterpriseWalletClient) Contract() *contracts.EnterpriseWallet {
+	return c.contract
+}
+
+// GetPaymentAccounts returns all payment accounts
+func (c *EnterpriseWalletClient) GetPaymentAccounts(ctx context.Context) ([]AccountInfo, error) {
+	accounts, err := c.contract.GetPaymentAccounts(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get payment accounts: %w", err)
+	}
+	return accounts, nil
+}
+
+// GetCollectionAccounts returns all collection accounts
+func (c *EnterpriseWalletClient) GetCollectionAccounts(ctx context.Context) ([]AccountInfo, error) {
+	accounts, err := c.contract.GetCollectionAccounts(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get collection accounts: %w", err)
+	}
+	return accounts, nil
+}
+
+// IsPaymentAccount checks if account is a payment account of the wallet
+func (c *EnterpriseWalletClient) IsPaymentAccount(ctx context.Context, account common.Address) (bool, error) {
+	ok, err := c.contract.IsPaymentAccount(&bind.CallOpts{Context: ctx}, account)
+	if err != nil {
+		return false, fmt.Errorf("failed to check payment account: %w", err)
+	}
+	return ok, nil
+}
+
+// IsCollectionAccount checks if account is a collection account of the wallet
+func (c *EnterpriseWalletClient) IsCollectionAccount(ctx context.Context, account common.Address) (bool, error) {
+	ok, err := c.contract.IsCollectionAccount(&bind.CallOpts{Context: ctx}, account)
+	if err != nil {
+		return false, fmt.Errorf("failed to check collection account: %w", err)
+	}
+	return ok, nil
+}
+
+// PredictPaymentAccountAddress returns the address of the next payment account
+func (c *EnterpriseWalletClient) PredictPaymentAccountAddress(ctx context.Context) (common.Address, error) {
+	account, err := c.contract.PredictPaymentAccountAddress(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to predict payment account address: %w", err)
+	}
+	return account, nil
+}
+
+// PredictCollectionAccountAddress returns the address of the next collection account
+func (c *EnterpriseWalletClient) PredictCollectionAccountAddress(ctx context.Context) (common.Address, error) {
+	account, err := c.contract.PredictCollectionAccountAddress(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to predict collection account address: %w", err)
+	}
+	return account, nil
+}
+
+// GetPaymentAccountNonce returns the nonce the next payment account will be created with
+func (c *EnterpriseWalletClient) GetPaymentAccountNonce(ctx context.Context) (*big.Int, error) {
+	nonce, err := c.contract.GetPaymentAccountNonce(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get payment account nonce: %w", err)
+	}
+	return nonce, nil
+}
+
+// GetCollectionAccountNonce returns the nonce the next collection account will be created with
+func (c *EnterpriseWalletClient) GetCollectionAccountNonce(ctx context.Context) (*big.Int, error) {
+	nonce, err := c.contract.GetCollectionAccountNonce(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get collection account nonce: %w", err)
+	}
+	return nonce, nil
+}
+
+// PaymentAccountAddressAt returns the address of the payment account created with
+// the given nonce, without any RPC call
+func (c *EnterpriseWalletClient) PaymentAccountAddressAt(nonce *big.Int) common.Address {
+	return utils.CalculatePaymentAccountAddress(c.address, nonce)
+}
+
+// CollectionAccountAddressAt returns the address of the collection account created with
+// the given nonce, without any RPC call
+func (c *EnterpriseWalletClient) CollectionAccountAddressAt(nonce *big.Int) common.Address {
+	return utils.CalculateCollectionAccountAddress(c.address, nonce)
+}
+
+// NextPaymentAccountAddresses returns the addresses of the next count payment accounts,
+// e.g. to register them with a bank before createPaymentAccount or createSafeAndPaymentAccount runs
+func (c *EnterpriseWalletClient) NextPaymentAccountAddresses(ctx context.Context, count int) ([]common.Address, error) {
+	nonce, err := c.GetPaymentAccountNonce(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return nextAccountAddresses(nonce, count, c.PaymentAccountAddressAt), nil
+}
+
+// NextCollectionAccountAddresses returns the addresses of the next count collection accounts
+func (c *EnterpriseWalletClient) NextCollectionAccountAddresses(ctx context.Context, count int) ([]common.Address, error) {
+	nonce, err := c.GetCollectionAccountNonce(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return nextAccountAddresses(nonce, count, c.CollectionAccountAddressAt), nil
+}
+
+func nextAccountAddresses(nonce *big.Int, count int, addressAt func(*big.Int) common.Address) []common.Address {
+	addresses := make([]common.Address, 0, count)
+	for i := 0; i < count; i++ {
+		addresses = append(addresses, addressAt(new(big.Int).Add(nonce, big.NewInt(int64(i)))))
+	}
+	return addresses
+}
+
+// GetMethodController returns the controller allowed to call the method with the given signature,
+// e.g. "createPaymentAccount(string,address)"
+func (c *EnterpriseWalletClient) GetMethodController(ctx context.Context, signature string) (common.Address, error) {
+	config, err := c.contract.GetMethodConfig(&bind.CallOpts{Context: ctx}, utils.GetMethodSelector(signature))
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to get method config: %w", err)
+	}
+	return config.Controller, nil
+}
+
+// GetSuperAdmin returns the current super admin
+func (c *EnterpriseWalletClient) GetSuperAdmin(ctx context.Context) (common.Address, error) {
+	admin, err := c.contract.GetSuperAdmin(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to get super admin: %w", err)
+	}
+	return admin, nil
+}
+
+// GetSuperAdminTransfer returns the pending super admin transfer
+func (c *EnterpriseWalletClient) GetSuperAdminTransfer(ctx context.Context) (SuperAdminTransfer, error) {
+	transfer, err := c.contract.GetSuperAdminTransfer(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return SuperAdminTransfer{}, fmt.Errorf("failed to get super admin transfer: %w", err)
+	}
+	return transfer, nil
+}
+
+// IsPaused checks if the wallet is paused
+func (c *EnterpriseWalletClient) IsPaused(ctx context.Context) (bool, error) {
+	paused, err := c.contract.IsPaused(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return false, fmt.Errorf("failed to check paused state: %w", err)
+	}
+	return paused, nil
+}
+
+// IsFrozen checks if target is frozen
+func (c *EnterpriseWalletClient) IsFrozen(ctx context.Context, target common.Address) (bool, error) {
+	frozen, err := c.contract.IsFrozen(&bind.CallOpts{Context: ctx}, target)
+	if err != nil {
+		return false, fmt.Errorf("failed to check frozen state: %w", err)
+	}
+	return frozen, nil
+}
+
+// CreatePaymentAccount creates a payment account managed by controller
+func (c *EnterpriseWalletClient) CreatePaymentAccount(ctx context.Context, sender Sender, name string, controller common.Address) (*CallResult, error) {
+	return c.send(ctx, sender, "createPaymentAccount", name, controller)
+}
+
+// CreateCollectionAccount creates a collection account forwarding funds to collectionTarget
+func (c *EnterpriseWalletClient) CreateCollectionAccount(ctx context.Context, sender Sender, name string, collectionTarget common.Address) (*CallResult, error) {
+	return c.send(ctx, sender, "createCollectionAccount", name, collectionTarget)
+}
+
+// CreateSafeAndPaymentAccount deploys a Safe and creates a payment account controlled by it
+func (c *EnterpriseWalletClient) CreateSafeAndPaymentAccount(ctx context.Context, sender Sender, proxyFactory, safeSingleton common.Address, params utils.SafeSetupParams, name string) (*CallResult, error) {
+	return c.send(ctx, sender, "createSafeAndPaymentAccount", proxyFactory, safeSingleton, safeSetupParams(params), name)
+}
+
+// CreateSafeAndCollectionAccount deploys a Safe and creates a collection account forwarding to collectionTarget
+func (c *EnterpriseWalletClient) CreateSafeAndCollectionAccount(ctx context.Context, sender Sender, proxyFactory, safeSingleton common.Address, params utils.SafeSetupParams, name string, collectionTarget common.Address) (*CallResult, error) {
+	return c.send(ctx, sender, "createSafeAndCollectionAccount", proxyFactory, safeSingleton, safeSetupParams(params), name, collectionTarget)
+}
+
+// SetCollectionTarget changes where a collection account forwards its funds
+func (c *EnterpriseWalletClient) SetCollectionTarget(ctx context.Context, sender Sender, collectionAccount, target common.Address) (*CallResult, error) {
+	return c.send(ctx, sender, "setCollectionTarget", collectionAccount, target)
+}
+
+// CollectFunds moves token balance (zero address for ETH) from a collection account to its target
+func (c *EnterpriseWalletClient) CollectFunds(ctx context.Context, sender Sender, token, collectionAccount common.Address) (*CallResult, error) {
+	return c.send(ctx, sender, "collectFunds", token, collectionAccount)
+}
+
+// UpdatePaymentAccountController changes the controller of a payment account
+func (c *EnterpriseWalletClient) UpdatePaymentAccountController(ctx context.Context, sender Sender, paymentAccount, controller common.Address) (*CallResult, error) {
+	return c.send(ctx, sender, "updatePaymentAccountController", paymentAccount, controller)
+}
+
+// UpdateMethodController changes the controller of a single method
+func (c *EnterpriseWalletClient) UpdateMethodController(ctx context.Context, sender Sender, methodSig [4]byte, controller common.Address) (*CallResult, error) {
+	return c.send(ctx, sender, "updateMethodController", methodSig, controller)
+}
+
+// UpdateMethodControllers changes the controllers of several methods
+func (c *EnterpriseWalletClient) UpdateMethodControllers(ctx context.Context, sender Sender, methodSigs [][4]byte, controllers []common.Address) (*CallResult, error) {
+	if len(methodSigs) != len(controllers) {
+		return nil, fmt.Errorf("method count %d does not match controller count %d", len(methodSigs), len(controllers))
+	}
+	return c.send(ctx, sender, "updateMethodControllers", methodSigs, controllers)
+}
+
+// SetMethodController assigns one controller to several methods
+func (c *EnterpriseWalletClient) SetMethodController(ctx context.Context, sender Sender, methodSigs [][4]byte, controller common.Address) (*CallResult, error) {
+	return c.send(ctx, sender, "setMethodController", methodSigs, controller)
+}
+
+// EmergencyFreeze freezes or unfreezes a payment or collection account
+func (c *EnterpriseWalletClient) EmergencyFreeze(ctx context.Context, sender Sender, target common.Address, freeze bool) (*CallResult, error) {
+	return c.send(ctx, sender, "emergencyFreeze", target, freeze)
+}
+
+// EmergencyPause pauses or unpauses the whole wallet
+func (c *EnterpriseWalletClient) EmergencyPause(ctx context.Context, sender Sender, pause bool) (*CallResult, error) {
+	return c.send(ctx, sender, "emergencyPause", pause)
+}
+
+// ProposeSuperAdminTransfer proposes newSuperAdmin, who must confirm within timeout seconds
+func (c *EnterpriseWalletClient) ProposeSuperAdminTransfer(ctx context.Context, sender Sender, newSuperAdmin common.Address, timeout *big.Int) (*CallResult, error) {
+	return c.send(ctx, sender, "proposeSuperAdminTransfer", newSuperAdmin, timeout)
+}
+
+// ConfirmSuperAdminTransfer confirms the pending transfer; sender must be the proposed super admin
+func (c *EnterpriseWalletClient) ConfirmSuperAdminTransfer(ctx context.Context, sender Sender) (*CallResult, error) {
+	return c.send(ctx, sender, "confirmSuperAdminTransfer")
+}
+
+// CancelSuperAdminTransfer cancels the pending transfer
+func (c *EnterpriseWalletClient) CancelSuperAdminTransfer(ctx context.Context, sender Sender) (*CallResult, error) {
+	return c.send(ctx, sender, "cancelSuperAdminTransfer")
+}
+
+// send packs the wallet method call and hands it to sender
+func (c *EnterpriseWalletClient) send(ctx context.Context, sender Sender, method string, args ...interface{}) (*CallResult, error) {
+	data, err := c.abi.Pack(method, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s call: %w", method, err)
+	}
+
+	result, err := sender.Send(ctx, Call{To: c.address, Value: big.NewInt(0), Data: data})
+	if err != nil {
+		return nil, fmt.Errorf("failed to send %s: %w", method, err)
+	}
+
+	return result, nil
+}
+
+// safeSetupParams converts SDK Safe setup params to the binding struct
+func safeSetupParams(params utils.SafeSetupParams) contracts.IEnterpriseWalletTypesSafeSetupParams {
+	return contracts.IEnterpriseWalletTypesSafeSetupParams{
+		Owners:          params.Owners,
+		Threshold:       bigOrZero(params.Threshold),
+		To:              params.To,
+		Data:            params.Data,
+		FallbackHandler: params.FallbackHandler,
+		PaymentToken:    params.PaymentToken,
+		Payment:         bigOrZero(params.Payment),
+		PaymentReceiver: params.PaymentReceiver,
+		SaltNonce:       bigOrZero(params.SaltNonce),
+	}
+}
+
+func bigOrZero(value *big.Int) *big.Int {
+	if value == nil {
+		return big.NewInt(0)
+	}
+	return value
+}
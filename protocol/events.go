@@ -0,0 +1,165 @@
+package protocol
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/vikkkko/safe-core-sdk-golang/protocol/utils"
+)
+
+// SafeEventType identifies a Safe contract event
+type SafeEventType string
+
+const (
+	SafeEventExecutionSuccess           SafeEventType = "ExecutionSuccess"
+	SafeEventExecutionFailure           SafeEventType = "ExecutionFailure"
+	SafeEventExecutionFromModuleSuccess SafeEventType = "ExecutionFromModuleSuccess"
+	SafeEventExecutionFromModuleFailure SafeEventType = "ExecutionFromModuleFailure"
+	SafeEventAddedOwner                 SafeEventType = "AddedOwner"
+	SafeEventRemovedOwner               SafeEventType = "RemovedOwner"
+	SafeEventChangedThreshold           SafeEventType = "ChangedThreshold"
+	SafeEventEnabledModule              SafeEventType = "EnabledModule"
+	SafeEventDisabledModule             SafeEventType = "DisabledModule"
+	SafeEventChangedGuard               SafeEventType = "ChangedGuard"
+	SafeEventChangedFallbackHandler     SafeEventType = "ChangedFallbackHandler"
+	SafeEventSafeReceived               SafeEventType = "SafeReceived"
+	SafeEventApproveHash                SafeEventType = "ApproveHash"
+	SafeEventSignMsg                    SafeEventType = "SignMsg"
+)
+
+// safeEventFactories creates the typed payload for each supported event
+var safeEventFactories = map[SafeEventType]func() interface{}{
+	SafeEventExecutionSuccess:           func() interface{} { return new(utils.SafeContractExecutionSuccess) },
+	SafeEventExecutionFailure:           func() interface{} { return new(utils.SafeContractExecutionFailure) },
+	SafeEventExecutionFromModuleSuccess: func() interface{} { return new(utils.SafeContractExecutionFromModuleSuccess) },
+	SafeEventExecutionFromModuleFailure: func() interface{} { return new(utils.SafeContractExecutionFromModuleFailure) },
+	SafeEventAddedOwner:                 func() interface{} { return new(utils.SafeContractAddedOwner) },
+	SafeEventRemovedOwner:               func() interface{} { return new(utils.SafeContractRemovedOwner) },
+	SafeEventChangedThreshold:           func() interface{} { return new(utils.SafeContractChangedThreshold) },
+	SafeEventEnabledModule:              func() interface{} { return new(utils.SafeContractEnabledModule) },
+	SafeEventDisabledModule:             func() interface{} { return new(utils.SafeContractDisabledModule) },
+	SafeEventChangedGuard:               func() interface{} { return new(utils.SafeContractChangedGuard) },
+	SafeEventChangedFallbackHandler:     func() interface{} { return new(utils.SafeContractChangedFallbackHandler) },
+	SafeEventSafeReceived:               func() interface{} { return new(utils.SafeContractSafeReceived) },
+	SafeEventApproveHash:                func() interface{} { return new(utils.SafeContractApproveHash) },
+	SafeEventSignMsg:                    func() interface{} { return new(utils.SafeContractSignMsg) },
+}
+
+// SafeEvent represents a decoded Safe contract event
+type SafeEvent struct {
+	Type        SafeEventType `json:"type"`
+	BlockNumber uint64        `json:"blockNumber"`
+	BlockHash   common.Hash   `json:"blockHash"`
+	TxHash      common.Hash   `json:"txHash"`
+	LogIndex    uint          `json:"logIndex"`
+	Removed     bool          `json:"removed"` // True when the log was dropped by a chain reorganisation
+	// Data holds the typed payload, e.g. *utils.SafeContractExecutionSuccess
+	Data interface{}   `json:"data"`
+	Raw  gethtypes.Log `json:"-"`
+}
+
+// SafeEventTopic returns the topic hash of a Safe event
+func SafeEventTopic(eventType SafeEventType) (common.Hash, error) {
+	safeABI, err := utils.SafeContractMetaData.GetAbi()
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to get Safe ABI: %w", err)
+	}
+
+	event, ok := safeABI.Events[string(eventType)]
+	if !ok {
+		return common.Hash{}, fmt.Errorf("unknown Safe event: %s", eventType)
+	}
+
+	return event.ID, nil
+}
+
+// DecodeSafeLog decodes a Safe log into a typed event. Logs emitted by Safe
+// versions that do not index event arguments (1.3.0 and earlier) are supported.
+func DecodeSafeLog(log gethtypes.Log) (*SafeEvent, error) {
+	if len(log.Topics) == 0 {
+		return nil, fmt.Errorf("log has no topics")
+	}
+
+	safeABI, err := utils.SafeContractMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Safe ABI: %w", err)
+	}
+
+	event, err := safeABI.EventByID(log.Topics[0])
+	if err != nil {
+		return nil, fmt.Errorf("unknown Safe event topic %s: %w", log.Topics[0].Hex(), err)
+	}
+
+	factory, ok := safeEventFactories[SafeEventType(event.Name)]
+	if !ok {
+		return nil, fmt.Errorf("unsupported Safe event: %s", event.Name)
+	}
+
+	out := factory()
+	if err := unpackSafeLog(out, *event, log); err != nil {
+		return nil, fmt.Errorf("failed to decode %s log: %w", event.Name, err)
+	}
+
+	return &SafeEvent{
+		Type:        SafeEventType(event.Name),
+		BlockNumber: log.BlockNumber,
+		BlockHash:   log.BlockHash,
+		TxHash:      log.TxHash,
+		LogIndex:    log.Index,
+		Removed:     log.Removed,
+		Data:        out,
+		Raw:         log,
+	}, nil
+}
+
+// unpackSafeLog fills out from the log, treating every argument as non-indexed
+// when the log carries no indexed topics
+func unpackSafeLog(out interface{}, event abi.Event, log gethtypes.Log) error {
+	var indexed, nonIndexed abi.Arguments
+	for _, input := range event.Inputs {
+		if input.Indexed && len(log.Topics) > 1 {
+			indexed = append(indexed, input)
+			continue
+		}
+		input.Indexed = false
+		nonIndexed = append(nonIndexed, input)
+	}
+
+	if len(log.Topics)-1 != len(indexed) {
+		return fmt.Errorf("expected %d indexed topics, got %d", len(indexed), len(log.Topics)-1)
+	}
+
+	if len(nonIndexed) > 0 {
+		values, err := nonIndexed.Unpack(log.Data)
+		if err != nil {
+			return err
+		}
+		// Copy by name; Arguments.Copy maps a lone value onto the first struct field
+		dst := reflect.ValueOf(out).Elem()
+		for i, input := range nonIndexed {
+			field := dst.FieldByName(abi.ToCamelCase(input.Name))
+			value := reflect.ValueOf(values[i])
+			if !field.IsValid() || !value.Type().AssignableTo(field.Type()) {
+				return fmt.Errorf("cannot assign %s argument %s", value.Type(), input.Name)
+			}
+			field.Set(value)
+		}
+	}
+
+	if err := abi.ParseTopics(out, indexed, log.Topics[1:]); err != nil {
+		return err
+	}
+
+	// Every generated event struct carries the raw log
+	setRawLog(out, log)
+	return nil
+}
+
+func setRawLog(out interface{}, log gethtypes.Log) {
+	if field := reflect.ValueOf(out).Elem().FieldByName("Raw"); field.IsValid() && field.CanSet() {
+		field.Set(reflect.ValueOf(log))
+	}
+}
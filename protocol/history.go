@@ -0,0 +1,394 @@
+package protocol
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/vikkkko/safe-core-sdk-golang/api"
+	"github.com/vikkkko/safe-core-sdk-golang/protocol/utils"
+)
+
+const defaultHistoryBlockRange = 2000
+
+// HistoryScanOptions represents options for scanning Safe transaction history from chain
+type HistoryScanOptions struct {
+	FromBlock  uint64  // First block to scan
+	ToBlock    *uint64 // Last block to scan (defaults to the chain head)
+	BlockRange uint64  // Blocks per eth_getLogs page (default 2000)
+	Limit      int     // Stop after this many transactions (0 for no limit)
+}
+
+// execTransactionCall holds decoded execTransaction arguments
+type execTransactionCall struct {
+	to             common.Address
+	value          *big.Int
+	data           []byte
+	operation      uint8
+	safeTxGas      *big.Int
+	baseGas        *big.Int
+	gasPrice       *big.Int
+	gasToken       common.Address
+	refundReceiver common.Address
+	signatures     []byte
+}
+
+// historyScanner caches per-block and per-transaction lookups during a scan
+type historyScanner struct {
+	safe       *Safe
+	chainID    *big.Int
+	nonce      uint64 // current Safe nonce, upper bound for nonce matching
+	nextNonce  uint64 // nonce expected for the next execution
+	headers    map[uint64]*gethtypes.Header
+	txs        map[common.Hash]*gethtypes.Transaction
+	receipts   map[common.Hash]*gethtypes.Receipt
+	thresholds map[uint64]int // threshold before each execution block (0 if unavailable)
+}
+
+// ScanTransactionHistory rebuilds executed multisig transactions from
+// ExecutionSuccess and ExecutionFailure logs, without the Transaction Service.
+// Records use the Transaction Service shape; Nonce is -1 when it cannot be
+// matched, and transactions relayed through contracts that do not embed the
+// execTransaction calldata only carry the fields known from the log.
+// ConfirmationsRequired is the threshold at the end of the previous block, read
+// with getThreshold; it is 0 when the node cannot serve that historical state.
+// Records whose signatures cannot be decoded have no confirmations and carry the
+// error in SignaturesError.
+func (s *Safe) ScanTransactionHistory(ctx context.Context, opts HistoryScanOptions) ([]api.SafeMultisigTransactionResponse, error) {
+	successTopic, err := SafeEventTopic(SafeEventExecutionSuccess)
+	if err != nil {
+		return nil, err
+	}
+	failureTopic, err := SafeEventTopic(SafeEventExecutionFailure)
+	if err != nil {
+		return nil, err
+	}
+
+	toBlock := opts.ToBlock
+	if toBlock == nil {
+		head, err := s.client.BlockNumber(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get block number: %w", err)
+		}
+		toBlock = &head
+	}
+
+	blockRange := opts.BlockRange
+	if blockRange == 0 {
+		blockRange = defaultHistoryBlockRange
+	}
+
+	nonce, err := s.GetNonce(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get nonce: %w", err)
+	}
+
+	scanner := &historyScanner{
+		safe:       s,
+		chainID:    big.NewInt(s.config.ChainID),
+		nonce:      nonce,
+		headers:    make(map[uint64]*gethtypes.Header),
+		txs:        make(map[common.Hash]*gethtypes.Transaction),
+		receipts:   make(map[common.Hash]*gethtypes.Receipt),
+		thresholds: make(map[uint64]int),
+	}
+
+	var records []api.SafeMultisigTransactionResponse
+	for from := opts.FromBlock; from <= *toBlock; from += blockRange {
+		to := from + blockRange - 1
+		if to > *toBlock {
+			to = *toBlock
+		}
+
+		logs, err := s.client.FilterLogs(ctx, ethereum.FilterQuery{
+			FromBlock: new(big.Int).SetUint64(from),
+			ToBlock:   new(big.Int).SetUint64(to),
+			Addresses: []common.Address{s.GetAddress()},
+			Topics:    [][]common.Hash{{successTopic, failureTopic}},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to filter logs from block %d to %d: %w", from, to, err)
+		}
+
+		sort.Slice(logs, func(i, j int) bool {
+			if logs[i].BlockNumber != logs[j].BlockNumber {
+				return logs[i].BlockNumber < logs[j].BlockNumber
+			}
+			return logs[i].Index < logs[j].Index
+		})
+
+		for _, log := range logs {
+			record, err := scanner.record(ctx, log)
+			if err != nil {
+				return nil, err
+			}
+			records = append(records, *record)
+
+			if opts.Limit > 0 && len(records) >= opts.Limit {
+				return records, nil
+			}
+		}
+	}
+
+	return records, nil
+}
+
+// record builds a history record for one execution log
+func (h *historyScanner) record(ctx context.Context, log gethtypes.Log) (*api.SafeMultisigTransactionResponse, error) {
+	event, err := DecodeSafeLog(log)
+	if err != nil {
+		return nil, err
+	}
+
+	var safeTxHash [32]byte
+	successful := false
+	switch data := event.Data.(type) {
+	case *utils.SafeContractExecutionSuccess:
+		safeTxHash, successful = data.TxHash, true
+	case *utils.SafeContractExecutionFailure:
+		safeTxHash = data.TxHash
+	}
+
+	header, err := h.header(ctx, log.BlockNumber)
+	if err != nil {
+		return nil, err
+	}
+	tx, receipt, err := h.transaction(ctx, log.TxHash)
+	if err != nil {
+		return nil, err
+	}
+
+	executionDate := time.Unix(int64(header.Time), 0).UTC()
+	blockNumber := int64(log.BlockNumber)
+	txHash := log.TxHash.Hex()
+	gasUsed := int64(receipt.GasUsed)
+
+	record := &api.SafeMultisigTransactionResponse{
+		Safe:            h.safe.GetAddress().Hex(),
+		Nonce:           -1,
+		ExecutionDate:   &executionDate,
+		SubmissionDate:  executionDate,
+		Modified:        executionDate,
+		BlockNumber:     &blockNumber,
+		TransactionHash: &txHash,
+		SafeTxHash:      common.Hash(safeTxHash).Hex(),
+		IsExecuted:      true,
+		IsSuccessful:    &successful,
+		GasUsed:         &gasUsed,
+		Trusted:         true,
+	}
+
+	if sender, err := gethtypes.Sender(gethtypes.LatestSignerForChainID(tx.ChainId()), tx); err == nil {
+		executor := sender.Hex()
+		record.Executor = &executor
+	}
+	effectiveGasPrice := tx.GasPrice()
+	if header.BaseFee != nil {
+		effectiveGasPrice = new(big.Int).Add(header.BaseFee, tx.EffectiveGasTipValue(header.BaseFee))
+	}
+	ethGasPrice := effectiveGasPrice.String()
+	fee := new(big.Int).Mul(effectiveGasPrice, new(big.Int).SetUint64(receipt.GasUsed)).String()
+	record.EthGasPrice = &ethGasPrice
+	record.Fee = &fee
+	if tx.Type() == gethtypes.DynamicFeeTxType {
+		maxFee, maxPriority := tx.GasFeeCap().String(), tx.GasTipCap().String()
+		record.MaxFeePerGas = &maxFee
+		record.MaxPriorityFeePerGas = &maxPriority
+	}
+
+	call, nonce, ok := h.matchExecTransaction(tx, safeTxHash)
+	if !ok {
+		return record, nil
+	}
+
+	record.To = call.to.Hex()
+	record.Value = call.value.String()
+	record.Data = hexutil.Encode(call.data)
+	record.Operation = int(call.operation)
+	record.GasToken = call.gasToken.Hex()
+	record.SafeTxGas = call.safeTxGas.Int64()
+	record.BaseGas = call.baseGas.Int64()
+	record.GasPrice = call.gasPrice.String()
+	record.RefundReceiver = call.refundReceiver.Hex()
+	signatures := hexutil.Encode(call.signatures)
+	record.Signatures = &signatures
+	if nonce >= 0 {
+		record.Nonce = nonce
+		h.nextNonce = uint64(nonce) + 1
+	}
+
+	// The signatures are not part of safeTxHash, so a malformed tail must only
+	// affect this record
+	record.ConfirmationsRequired = h.threshold(ctx, log.BlockNumber)
+	decoded, err := utils.DecodeSafeSignatures(safeTxHash[:], call.signatures, record.ConfirmationsRequired)
+	if err != nil {
+		record.SignaturesError = fmt.Sprintf("failed to decode signatures: %v", err)
+		return record, nil
+	}
+	for _, sig := range decoded {
+		record.Confirmations = append(record.Confirmations, api.SafeMultisigConfirmationResponse{
+			Owner:          sig.Signer.Hex(),
+			SubmissionDate: executionDate,
+			Signature:      hexutil.Encode(sig.Signature),
+			SignatureType:  sig.Type,
+		})
+	}
+
+	return record, nil
+}
+
+// matchExecTransaction finds the execTransaction call in the transaction input
+// that hashes to safeTxHash. Direct calls and calldata embedded in relayer or
+// batch calls are both searched. The nonce is -1 when no candidate matches.
+func (h *historyScanner) matchExecTransaction(tx *gethtypes.Transaction, safeTxHash [32]byte) (*execTransactionCall, int64, bool) {
+	safeABI, err := utils.SafeContractMetaData.GetAbi()
+	if err != nil {
+		return nil, -1, false
+	}
+	method := safeABI.Methods["execTransaction"]
+
+	input := tx.Data()
+	var fallback *execTransactionCall
+	for offset := 0; offset+4 <= len(input); offset++ {
+		index := bytes.Index(input[offset:], method.ID)
+		if index < 0 {
+			break
+		}
+		offset += index
+
+		call, err := decodeExecTransaction(method, input[offset+4:])
+		if err != nil {
+			continue
+		}
+		if nonce, ok := h.matchNonce(call, safeTxHash); ok {
+			return call, int64(nonce), true
+		}
+		if offset == 0 && tx.To() != nil && *tx.To() == h.safe.GetAddress() {
+			fallback = call
+		}
+	}
+
+	if fallback != nil {
+		return fallback, -1, true
+	}
+	return nil, -1, false
+}
+
+// matchNonce searches for the nonce that makes the call hash to safeTxHash,
+// starting from the nonce following the previous match
+func (h *historyScanner) matchNonce(call *execTransactionCall, safeTxHash [32]byte) (uint64, bool) {
+	matches := func(nonce uint64) bool {
+		hash, err := utils.CalculateTransactionHash(
+			h.safe.GetAddress(), call.to, call.value, call.data, call.operation,
+			call.safeTxGas, call.baseGas, call.gasPrice, call.gasToken, call.refundReceiver,
+			new(big.Int).SetUint64(nonce), h.chainID,
+		)
+		return err == nil && bytes.Equal(hash, safeTxHash[:])
+	}
+
+	for nonce := h.nextNonce; nonce < h.nonce; nonce++ {
+		if matches(nonce) {
+			return nonce, true
+		}
+	}
+	for nonce := uint64(0); nonce < h.nextNonce && nonce < h.nonce; nonce++ {
+		if matches(nonce) {
+			return nonce, true
+		}
+	}
+	return 0, false
+}
+
+// decodeExecTransaction unpacks execTransaction arguments (calldata without selector)
+func decodeExecTransaction(method abi.Method, data []byte) (*execTransactionCall, error) {
+	values, err := method.Inputs.Unpack(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(values) != 10 {
+		return nil, fmt.Errorf("unexpected execTransaction argument count: %d", len(values))
+	}
+
+	call := &execTransactionCall{}
+	var ok [10]bool
+	call.to, ok[0] = values[0].(common.Address)
+	call.value, ok[1] = values[1].(*big.Int)
+	call.data, ok[2] = values[2].([]byte)
+	call.operation, ok[3] = values[3].(uint8)
+	call.safeTxGas, ok[4] = values[4].(*big.Int)
+	call.baseGas, ok[5] = values[5].(*big.Int)
+	call.gasPrice, ok[6] = values[6].(*big.Int)
+	call.gasToken, ok[7] = values[7].(common.Address)
+	call.refundReceiver, ok[8] = values[8].(common.Address)
+	call.signatures, ok[9] = values[9].([]byte)
+	for i := range ok {
+		if !ok[i] {
+			return nil, fmt.Errorf("unexpected type for execTransaction argument %d", i)
+		}
+	}
+
+	return call, nil
+}
+
+func (h *historyScanner) header(ctx context.Context, number uint64) (*gethtypes.Header, error) {
+	if header, ok := h.headers[number]; ok {
+		return header, nil
+	}
+
+	header, err := h.safe.client.HeaderByNumber(ctx, new(big.Int).SetUint64(number))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get header %d: %w", number, err)
+	}
+	h.headers[number] = header
+	return header, nil
+}
+
+// threshold returns the Safe threshold in effect for executions in block number,
+// or 0 if the state before the block is not available
+func (h *historyScanner) threshold(ctx context.Context, number uint64) int {
+	if number == 0 {
+		return 0
+	}
+	if threshold, ok := h.thresholds[number]; ok {
+		return threshold
+	}
+
+	threshold := 0
+	caller, err := utils.NewSafeContractCaller(h.safe.GetAddress(), h.safe.client)
+	if err == nil {
+		value, err := caller.GetThreshold(&bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(number - 1)})
+		if err == nil {
+			threshold = int(value.Int64())
+		}
+	}
+	h.thresholds[number] = threshold
+	return threshold
+}
+
+func (h *historyScanner) transaction(ctx context.Context, hash common.Hash) (*gethtypes.Transaction, *gethtypes.Receipt, error) {
+	if tx, ok := h.txs[hash]; ok {
+		return tx, h.receipts[hash], nil
+	}
+
+	tx, _, err := h.safe.client.TransactionByHash(ctx, hash)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get transaction %s: %w", hash.Hex(), err)
+	}
+	receipt, err := h.safe.client.TransactionReceipt(ctx, hash)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get receipt %s: %w", hash.Hex(), err)
+	}
+
+	h.txs[hash] = tx
+	h.receipts[hash] = receipt
+	return tx, receipt, nil
+}
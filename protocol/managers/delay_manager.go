@@ -0,0 +1,566 @@
+package managers
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/vikkkko/safe-core-sdk-golang/protocol/contracts"
+	"github.com/vikkkko/safe-core-sdk-golang/types"
+)
+
+// DefaultModuleProxyFactoryAddress is the canonical Zodiac ModuleProxyFactory address
+var DefaultModuleProxyFactoryAddress = common.HexToAddress("0x000000000000aDdB49795b0f9bA5BC298cDda236")
+
+// DelayTxStatus represents the state of a transaction in the Delay modifier queue
+type DelayTxStatus string
+
+const (
+	DelayTxCooldown DelayTxStatus = "cooldown" // Queued, cooldown has not passed yet
+	DelayTxReady    DelayTxStatus = "ready"    // Cooldown passed, can be executed
+	DelayTxExpired  DelayTxStatus = "expired"  // Expiration passed, can only be skipped
+)
+
+// DelayQueueItem represents a queued transaction in the Delay modifier
+type DelayQueueItem struct {
+	Nonce     *big.Int            `json:"nonce"`               // Queue nonce of the transaction
+	TxHash    common.Hash         `json:"txHash"`              // Delay transaction hash
+	To        common.Address      `json:"to"`                  // Target address
+	Value     *big.Int            `json:"value"`               // Value in wei
+	Data      []byte              `json:"data"`                // Transaction data
+	Operation types.OperationType `json:"operation"`           // Operation type
+	CreatedAt time.Time           `json:"createdAt"`           // Time the transaction was queued
+	ReadyAt   time.Time           `json:"readyAt"`             // Time the cooldown ends
+	ExpiresAt *time.Time          `json:"expiresAt,omitempty"` // Last time the transaction can be executed (nil if no expiration)
+	Status    DelayTxStatus       `json:"status"`              // Status at the latest block
+}
+
+// DelayStatus represents the configuration and queue pointers of a Delay modifier
+type DelayStatus struct {
+	Address    common.Address `json:"address"`    // Delay modifier address
+	Enabled    bool           `json:"enabled"`    // Whether the modifier is enabled on the Safe
+	Owner      common.Address `json:"owner"`      // Owner allowed to configure the modifier
+	Avatar     common.Address `json:"avatar"`     // Avatar (Safe) the modifier belongs to
+	Target     common.Address `json:"target"`     // Target executing the transactions
+	Cooldown   *big.Int       `json:"cooldown"`   // Cooldown in seconds
+	Expiration *big.Int       `json:"expiration"` // Expiration in seconds (0 means never)
+	TxNonce    *big.Int       `json:"txNonce"`    // Nonce of the next transaction to execute
+	QueueNonce *big.Int       `json:"queueNonce"` // Nonce the next queued transaction will get
+}
+
+// DeployDelayParams represents parameters for deploying a Delay modifier
+type DeployDelayParams struct {
+	MasterCopy common.Address // Delay modifier mastercopy
+	Factory    common.Address // ModuleProxyFactory (defaults to DefaultModuleProxyFactoryAddress)
+	Owner      common.Address // Owner of the modifier (defaults to the Safe)
+	Avatar     common.Address // Avatar of the modifier (defaults to the Safe)
+	Target     common.Address // Target of the modifier (defaults to the Safe)
+	Cooldown   *big.Int       // Cooldown in seconds
+	Expiration *big.Int       // Expiration in seconds (0 means never)
+	SaltNonce  *big.Int       // Salt nonce for the deterministic deployment
+}
+
+// DelayDeployment represents a prepared Delay modifier deployment
+type DelayDeployment struct {
+	Factory          common.Address // ModuleProxyFactory to call
+	Data             []byte         // deployModule calldata
+	PredictedAddress common.Address // Address the modifier will be deployed at
+}
+
+// DelayManager manages a Zodiac Delay modifier attached to a Safe
+type DelayManager struct {
+	client        *ethclient.Client
+	safeAddress   common.Address
+	delayAddress  common.Address
+	moduleManager *ModuleManager
+}
+
+// NewDelayManager creates a new Delay modifier manager
+func NewDelayManager(client *ethclient.Client, moduleManager *ModuleManager, delayAddress common.Address) *DelayManager {
+	return &DelayManager{
+		client:        client,
+		safeAddress:   moduleManager.safeAddress,
+		delayAddress:  delayAddress,
+		moduleManager: moduleManager,
+	}
+}
+
+// Address returns the Delay modifier address
+func (dm *DelayManager) Address() common.Address {
+	return dm.delayAddress
+}
+
+// CreateDeployDelayTx prepares the ModuleProxyFactory call deploying a Delay modifier for the Safe
+func (dm *DelayManager) CreateDeployDelayTx(params DeployDelayParams) (*DelayDeployment, error) {
+	return PrepareDelayDeployment(dm.safeAddress, params)
+}
+
+// PrepareDelayDeployment builds the deployModule calldata and predicts the Delay modifier address
+func PrepareDelayDeployment(safeAddress common.Address, params DeployDelayParams) (*DelayDeployment, error) {
+	if params.MasterCopy == (common.Address{}) {
+		return nil, fmt.Errorf("delay mastercopy address is required")
+	}
+
+	factory := params.Factory
+	if factory == (common.Address{}) {
+		factory = DefaultModuleProxyFactoryAddress
+	}
+
+	owner := addressOrDefault(params.Owner, safeAddress)
+	avatar := addressOrDefault(params.Avatar, safeAddress)
+	target := addressOrDefault(params.Target, safeAddress)
+
+	cooldown := bigIntOrZero(params.Cooldown)
+	expiration := bigIntOrZero(params.Expiration)
+	saltNonce := bigIntOrZero(params.SaltNonce)
+
+	if expiration.Sign() != 0 && expiration.Cmp(big.NewInt(60)) < 0 {
+		return nil, fmt.Errorf("expiration must be 0 or at least 60 seconds")
+	}
+
+	initializer, err := DelaySetUpData(owner, avatar, target, cooldown, expiration)
+	if err != nil {
+		return nil, err
+	}
+
+	factoryABI, err := contracts.ModuleProxyFactoryMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ModuleProxyFactory ABI: %w", err)
+	}
+
+	data, err := factoryABI.Pack("deployModule", params.MasterCopy, initializer, saltNonce)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack deployModule call: %w", err)
+	}
+
+	return &DelayDeployment{
+		Factory:          factory,
+		Data:             data,
+		PredictedAddress: PredictModuleProxyAddress(factory, params.MasterCopy, initializer, saltNonce),
+	}, nil
+}
+
+// DelaySetUpData encodes the setUp call used to initialize a Delay modifier proxy
+func DelaySetUpData(owner, avatar, target common.Address, cooldown, expiration *big.Int) ([]byte, error) {
+	addressType, _ := abi.NewType("address", "", nil)
+	uintType, _ := abi.NewType("uint256", "", nil)
+
+	initParams, err := abi.Arguments{
+		{Type: addressType},
+		{Type: addressType},
+		{Type: addressType},
+		{Type: uintType},
+		{Type: uintType},
+	}.Pack(owner, avatar, target, cooldown, expiration)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode Delay setUp params: %w", err)
+	}
+
+	delayABI, err := contracts.DelayModifierMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Delay ABI: %w", err)
+	}
+
+	data, err := delayABI.Pack("setUp", initParams)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack setUp call: %w", err)
+	}
+
+	return data, nil
+}
+
+// PredictModuleProxyAddress calculates the CREATE2 address of a Zodiac module proxy
+// This matches ModuleProxyFactory.deployModule logic
+func PredictModuleProxyAddress(factory, masterCopy common.Address, initializer []byte, saltNonce *big.Int) common.Address {
+	// salt = keccak256(abi.encodePacked(keccak256(initializer), saltNonce))
+	saltNonceBytes := make([]byte, 32)
+	saltNonce.FillBytes(saltNonceBytes)
+	salt := crypto.Keccak256(crypto.Keccak256(initializer), saltNonceBytes)
+
+	// Minimal proxy deployment code pointing at the mastercopy
+	deployment := common.FromHex("0x602d8060093d393df3363d3d373d3d3d363d73")
+	deployment = append(deployment, masterCopy.Bytes()...)
+	deployment = append(deployment, common.FromHex("0x5af43d82803e903d91602b57fd5bf3")...)
+
+	return crypto.CreateAddress2(factory, common.BytesToHash(salt), crypto.Keccak256(deployment))
+}
+
+// IsEnabled checks if the Delay modifier is enabled as a module on the Safe
+func (dm *DelayManager) IsEnabled(ctx context.Context) (bool, error) {
+	return dm.moduleManager.IsModuleEnabled(ctx, dm.delayAddress)
+}
+
+// CreateEnableTx creates a transaction enabling the Delay modifier on the Safe
+func (dm *DelayManager) CreateEnableTx(ctx context.Context) ([]byte, error) {
+	return dm.moduleManager.CreateEnableModuleTx(ctx, EnableModuleTxParams{
+		ModuleAddress: dm.delayAddress.Hex(),
+	})
+}
+
+// CreateDisableTx creates a transaction disabling the Delay modifier on the Safe
+func (dm *DelayManager) CreateDisableTx(ctx context.Context) ([]byte, error) {
+	return dm.moduleManager.CreateDisableModuleTx(ctx, DisableModuleTxParams{
+		ModuleAddress: dm.delayAddress.Hex(),
+	})
+}
+
+// GetStatus returns the Delay modifier configuration and queue pointers
+func (dm *DelayManager) GetStatus(ctx context.Context) (*DelayStatus, error) {
+	delay, err := contracts.NewDelayModifier(dm.delayAddress, dm.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Delay binding: %w", err)
+	}
+	opts := &bind.CallOpts{Context: ctx}
+
+	enabled, err := dm.IsEnabled(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check if Delay modifier is enabled: %w", err)
+	}
+
+	status := &DelayStatus{Address: dm.delayAddress, Enabled: enabled}
+
+	if status.Owner, err = delay.Owner(opts); err != nil {
+		return nil, fmt.Errorf("failed to get owner: %w", err)
+	}
+	if status.Avatar, err = delay.Avatar(opts); err != nil {
+		return nil, fmt.Errorf("failed to get avatar: %w", err)
+	}
+	if status.Target, err = delay.Target(opts); err != nil {
+		return nil, fmt.Errorf("failed to get target: %w", err)
+	}
+	if status.Cooldown, err = delay.TxCooldown(opts); err != nil {
+		return nil, fmt.Errorf("failed to get cooldown: %w", err)
+	}
+	if status.Expiration, err = delay.TxExpiration(opts); err != nil {
+		return nil, fmt.Errorf("failed to get expiration: %w", err)
+	}
+	if status.TxNonce, err = delay.TxNonce(opts); err != nil {
+		return nil, fmt.Errorf("failed to get tx nonce: %w", err)
+	}
+	if status.QueueNonce, err = delay.QueueNonce(opts); err != nil {
+		return nil, fmt.Errorf("failed to get queue nonce: %w", err)
+	}
+
+	return status, nil
+}
+
+// GetQueue returns the pending transactions of the Delay modifier, oldest first.
+// fromBlock limits the log search for the queued transaction parameters (use the modifier deployment block).
+func (dm *DelayManager) GetQueue(ctx context.Context, fromBlock uint64) ([]DelayQueueItem, error) {
+	delay, err := contracts.NewDelayModifier(dm.delayAddress, dm.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Delay binding: %w", err)
+	}
+	opts := &bind.CallOpts{Context: ctx}
+
+	txNonce, err := delay.TxNonce(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tx nonce: %w", err)
+	}
+	queueNonce, err := delay.QueueNonce(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get queue nonce: %w", err)
+	}
+	if txNonce.Cmp(queueNonce) >= 0 {
+		return []DelayQueueItem{}, nil
+	}
+
+	cooldown, err := delay.TxCooldown(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cooldown: %w", err)
+	}
+	expiration, err := delay.TxExpiration(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get expiration: %w", err)
+	}
+
+	header, err := dm.client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest block: %w", err)
+	}
+	now := time.Unix(int64(header.Time), 0)
+
+	// Transaction parameters are only stored in the TransactionAdded events
+	nonces := make([]*big.Int, 0)
+	for n := new(big.Int).Set(txNonce); n.Cmp(queueNonce) < 0; n = new(big.Int).Add(n, big.NewInt(1)) {
+		nonces = append(nonces, n)
+	}
+
+	iter, err := delay.FilterTransactionAdded(&bind.FilterOpts{Start: fromBlock, Context: ctx}, nonces, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to filter TransactionAdded events: %w", err)
+	}
+	defer iter.Close()
+
+	added := make(map[string]*contracts.DelayModifierTransactionAdded)
+	for iter.Next() {
+		added[iter.Event.QueueNonce.String()] = iter.Event
+	}
+	if err := iter.Error(); err != nil {
+		return nil, fmt.Errorf("failed to iterate TransactionAdded events: %w", err)
+	}
+
+	queue := make([]DelayQueueItem, 0, len(nonces))
+	for _, nonce := range nonces {
+		event, ok := added[nonce.String()]
+		if !ok {
+			return nil, fmt.Errorf("TransactionAdded event for queue nonce %s not found from block %d", nonce, fromBlock)
+		}
+
+		createdAt, err := delay.GetTxCreatedAt(opts, nonce)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get creation time of tx %s: %w", nonce, err)
+		}
+
+		item := DelayQueueItem{
+			Nonce:     nonce,
+			TxHash:    common.Hash(event.TxHash),
+			To:        event.To,
+			Value:     event.Value,
+			Data:      event.Data,
+			Operation: types.OperationType(event.Operation),
+			CreatedAt: time.Unix(createdAt.Int64(), 0),
+		}
+		item.ReadyAt = item.CreatedAt.Add(time.Duration(cooldown.Int64()) * time.Second)
+		if expiration.Sign() > 0 {
+			expiresAt := item.ReadyAt.Add(time.Duration(expiration.Int64()) * time.Second)
+			item.ExpiresAt = &expiresAt
+		}
+		item.Status = delayTxStatusAt(item, now)
+
+		queue = append(queue, item)
+	}
+
+	return queue, nil
+}
+
+// delayTxStatusAt returns the status of a queued transaction at the given time
+func delayTxStatusAt(item DelayQueueItem, now time.Time) DelayTxStatus {
+	if item.ExpiresAt != nil && now.After(*item.ExpiresAt) {
+		return DelayTxExpired
+	}
+	if now.Before(item.ReadyAt) {
+		return DelayTxCooldown
+	}
+	return DelayTxReady
+}
+
+// QueueTransaction queues a transaction in the Delay modifier through execTransactionFromModule.
+// The sender of opts must be a module enabled on the Delay modifier.
+func (dm *DelayManager) QueueTransaction(ctx context.Context, opts *bind.TransactOpts, tx types.MetaTransactionData) (*gethtypes.Transaction, error) {
+	if opts == nil {
+		return nil, fmt.Errorf("transaction options must not be nil")
+	}
+	if !common.IsHexAddress(tx.To) {
+		return nil, fmt.Errorf("invalid to address: %s", tx.To)
+	}
+
+	value := big.NewInt(0)
+	if tx.Value != "" {
+		var ok bool
+		if value, ok = new(big.Int).SetString(tx.Value, 10); !ok {
+			return nil, fmt.Errorf("invalid value: %s", tx.Value)
+		}
+	}
+
+	operation := types.Call
+	if tx.Operation != nil {
+		operation = *tx.Operation
+	}
+
+	delay, err := contracts.NewDelayModifier(dm.delayAddress, dm.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Delay binding: %w", err)
+	}
+
+	isModule, err := delay.IsModuleEnabled(&bind.CallOpts{Context: ctx}, opts.From)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check if sender is a Delay module: %w", err)
+	}
+	if !isModule {
+		return nil, fmt.Errorf("sender %s is not a module enabled on the Delay modifier", opts.From.Hex())
+	}
+
+	copyOpts := *opts
+	if copyOpts.Context == nil {
+		copyOpts.Context = ctx
+	}
+
+	return delay.ExecTransactionFromModule(&copyOpts, common.HexToAddress(tx.To), value, common.FromHex(tx.Data), uint8(operation))
+}
+
+// ExecuteNextTransaction executes the next queued transaction once its cooldown has passed.
+// Anyone can execute a ready transaction.
+func (dm *DelayManager) ExecuteNextTransaction(ctx context.Context, opts *bind.TransactOpts, fromBlock uint64) (*gethtypes.Transaction, error) {
+	if opts == nil {
+		return nil, fmt.Errorf("transaction options must not be nil")
+	}
+
+	queue, err := dm.GetQueue(ctx, fromBlock)
+	if err != nil {
+		return nil, err
+	}
+	if len(queue) == 0 {
+		return nil, fmt.Errorf("delay queue is empty")
+	}
+
+	next := queue[0]
+	switch next.Status {
+	case DelayTxCooldown:
+		return nil, fmt.Errorf("transaction %s is in cooldown until %s", next.Nonce, next.ReadyAt.UTC().Format(time.RFC3339))
+	case DelayTxExpired:
+		return nil, fmt.Errorf("transaction %s expired at %s, skip it first", next.Nonce, next.ExpiresAt.UTC().Format(time.RFC3339))
+	}
+
+	delay, err := contracts.NewDelayModifier(dm.delayAddress, dm.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Delay binding: %w", err)
+	}
+
+	copyOpts := *opts
+	if copyOpts.Context == nil {
+		copyOpts.Context = ctx
+	}
+
+	return delay.ExecuteNextTx(&copyOpts, next.To, next.Value, next.Data, uint8(next.Operation))
+}
+
+// SkipExpired advances the tx nonce past all expired transactions. Anyone can call it.
+func (dm *DelayManager) SkipExpired(ctx context.Context, opts *bind.TransactOpts) (*gethtypes.Transaction, error) {
+	if opts == nil {
+		return nil, fmt.Errorf("transaction options must not be nil")
+	}
+
+	delay, err := contracts.NewDelayModifier(dm.delayAddress, dm.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Delay binding: %w", err)
+	}
+
+	copyOpts := *opts
+	if copyOpts.Context == nil {
+		copyOpts.Context = ctx
+	}
+
+	return delay.SkipExpired(&copyOpts)
+}
+
+// CreateVetoTx creates a transaction for the Delay owner that vetoes every queued
+// transaction up to and including the given queue nonce by advancing the tx nonce
+func (dm *DelayManager) CreateVetoTx(ctx context.Context, nonce *big.Int) ([]byte, error) {
+	if nonce == nil {
+		return nil, fmt.Errorf("nonce is required")
+	}
+
+	delay, err := contracts.NewDelayModifier(dm.delayAddress, dm.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Delay binding: %w", err)
+	}
+	opts := &bind.CallOpts{Context: ctx}
+
+	txNonce, err := delay.TxNonce(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tx nonce: %w", err)
+	}
+	queueNonce, err := delay.QueueNonce(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get queue nonce: %w", err)
+	}
+
+	if nonce.Cmp(txNonce) < 0 || nonce.Cmp(queueNonce) >= 0 {
+		return nil, fmt.Errorf("nonce %s is not in the queue [%s, %s)", nonce, txNonce, queueNonce)
+	}
+
+	return dm.CreateSetTxNonceTx(new(big.Int).Add(nonce, big.NewInt(1)))
+}
+
+// CreateSkipNextTx creates a transaction for the Delay owner that vetoes the next queued transaction
+func (dm *DelayManager) CreateSkipNextTx(ctx context.Context) ([]byte, error) {
+	delay, err := contracts.NewDelayModifier(dm.delayAddress, dm.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Delay binding: %w", err)
+	}
+
+	txNonce, err := delay.TxNonce(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tx nonce: %w", err)
+	}
+
+	return dm.CreateVetoTx(ctx, txNonce)
+}
+
+// CreateVetoAllTx creates a transaction for the Delay owner that vetoes the whole queue
+func (dm *DelayManager) CreateVetoAllTx(ctx context.Context) ([]byte, error) {
+	delay, err := contracts.NewDelayModifier(dm.delayAddress, dm.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Delay binding: %w", err)
+	}
+
+	queueNonce, err := delay.QueueNonce(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get queue nonce: %w", err)
+	}
+	if queueNonce.Sign() == 0 {
+		return nil, fmt.Errorf("delay queue is empty")
+	}
+
+	return dm.CreateVetoTx(ctx, new(big.Int).Sub(queueNonce, big.NewInt(1)))
+}
+
+// CreateSetTxNonceTx creates a setTxNonce transaction for the Delay owner
+func (dm *DelayManager) CreateSetTxNonceTx(nonce *big.Int) ([]byte, error) {
+	return packDelayCall("setTxNonce", nonce)
+}
+
+// CreateSetCooldownTx creates a setTxCooldown transaction for the Delay owner
+func (dm *DelayManager) CreateSetCooldownTx(cooldown *big.Int) ([]byte, error) {
+	return packDelayCall("setTxCooldown", cooldown)
+}
+
+// CreateSetExpirationTx creates a setTxExpiration transaction for the Delay owner
+func (dm *DelayManager) CreateSetExpirationTx(expiration *big.Int) ([]byte, error) {
+	if expiration.Sign() != 0 && expiration.Cmp(big.NewInt(60)) < 0 {
+		return nil, fmt.Errorf("expiration must be 0 or at least 60 seconds")
+	}
+	return packDelayCall("setTxExpiration", expiration)
+}
+
+// CreateEnableDelayModuleTx creates a transaction for the Delay owner enabling a module
+// that is allowed to queue transactions in the Delay modifier
+func (dm *DelayManager) CreateEnableDelayModuleTx(module common.Address) ([]byte, error) {
+	return packDelayCall("enableModule", module)
+}
+
+func packDelayCall(method string, args ...interface{}) ([]byte, error) {
+	delayABI, err := contracts.DelayModifierMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Delay ABI: %w", err)
+	}
+
+	data, err := delayABI.Pack(method, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack %s call: %w", method, err)
+	}
+
+	return data, nil
+}
+
+func addressOrDefault(address, fallback common.Address) common.Address {
+	if address == (common.Address{}) {
+		return fallback
+	}
+	return address
+}
+
+func bigIntOrZero(value *big.Int) *big.Int {
+	if value == nil {
+		return big.NewInt(0)
+	}
+	return value
+}
@@ -2,16 +2,40 @@ package managers
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math/big"
+	"strings"
 
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/vikkkko/safe-core-sdk-golang/protocol/contracts"
+	"github.com/vikkkko/safe-core-sdk-golang/protocol/utils"
 )
 
+// Storage slots and interface IDs used by the guard safety checks
+var (
+	// GuardStorageSlot = keccak256("guard_manager.guard.address")
+	GuardStorageSlot = common.HexToHash("0x4a204f620c8c5ccdca3fd54d003badd85ba500436a431f0cbda4f558c93c34c8")
+	// safeThresholdSlot is the storage slot of the threshold in the Safe singleton layout
+	safeThresholdSlot = common.BigToHash(big.NewInt(4))
+
+	// GuardInterfaceID is type(Guard).interfaceId of the Safe transaction guard interface
+	GuardInterfaceID = [4]byte{0xe6, 0xd7, 0xa8, 0x3a}
+	// erc165InterfaceID is type(IERC165).interfaceId
+	erc165InterfaceID = [4]byte{0x01, 0xff, 0xc9, 0xa7}
+)
+
+const erc165ABI = `[{"inputs":[{"name":"interfaceId","type":"bytes4"}],"name":"supportsInterface","outputs":[{"name":"","type":"bool"}],"stateMutability":"view","type":"function"}]`
+
 // GuardManager manages Safe transaction guards
 type GuardManager struct {
 	client      *ethclient.Client
+	rpcClient   *rpc.Client
 	safeAddress common.Address
 }
 
@@ -23,6 +47,15 @@ func NewGuardManager(client *ethclient.Client, safeAddress common.Address) *Guar
 	}
 }
 
+// NewGuardManagerWithRPC creates a new guard manager able to simulate guards with state overrides
+func NewGuardManagerWithRPC(rpcClient *rpc.Client, safeAddress common.Address) *GuardManager {
+	return &GuardManager{
+		client:      ethclient.NewClient(rpcClient),
+		rpcClient:   rpcClient,
+		safeAddress: safeAddress,
+	}
+}
+
 // GetGuard returns the current transaction guard address
 func (gm *GuardManager) GetGuard(ctx context.Context) (common.Address, error) {
 	safeContract, err := contracts.NewSafeContract(gm.safeAddress, gm.client)
@@ -35,17 +68,13 @@ func (gm *GuardManager) GetGuard(ctx context.Context) (common.Address, error) {
 
 // SetGuardTxParams represents parameters for setting a guard
 type SetGuardTxParams struct {
-	GuardAddress string `json:"guardAddress"` // Address of the guard contract (use zero address to disable)
+	GuardAddress string `json:"guardAddress"`     // Address of the guard contract (use zero address to disable)
+	Unsafe       bool   `json:"unsafe,omitempty"` // Skip the guard safety checks (a bad guard can brick the Safe)
 }
 
-// CreateSetGuardTx creates a transaction to set a transaction guard
+// CreateSetGuardTx creates a transaction to set a transaction guard.
+// Unless params.Unsafe is set, a non-zero guard must pass CheckGuard.
 func (gm *GuardManager) CreateSetGuardTx(ctx context.Context, params SetGuardTxParams) ([]byte, error) {
-	// This is a placeholder implementation
-	// In a real implementation, this would:
-	// 1. Validate the guard address (can be zero address to disable guard)
-	// 2. Create the transaction data for setting the guard
-	// 3. Return the encoded transaction data
-
 	if params.GuardAddress != "" && !common.IsHexAddress(params.GuardAddress) {
 		return nil, fmt.Errorf("invalid guard address: %s", params.GuardAddress)
 	}
@@ -62,6 +91,13 @@ func (gm *GuardManager) CreateSetGuardTx(ctx context.Context, params SetGuardTxP
 		guardAddr = common.HexToAddress("0x0")
 	}
 
+	// Disabling the guard can never brick the Safe
+	if guardAddr != (common.Address{}) && !params.Unsafe {
+		if err := gm.CheckGuard(ctx, guardAddr); err != nil {
+			return nil, err
+		}
+	}
+
 	// Pack the setGuard function call
 	data, err := abi.Pack("setGuard", guardAddr)
 	if err != nil {
@@ -78,3 +114,172 @@ func (gm *GuardManager) CreateDisableGuardTx(ctx context.Context) ([]byte, error
 		GuardAddress: common.HexToAddress("0x0").Hex(),
 	})
 }
+
+// CheckGuard runs the guard safety checks:
+// 1. The guard address must contain code
+// 2. The guard must support the Guard interface through ERC-165
+// 3. A no-op Safe transaction must succeed with the guard in place
+// The simulation needs eth_call state overrides, so a manager created with
+// NewGuardManager rejects every guard; use NewGuardManagerWithRPC or set Unsafe.
+func (gm *GuardManager) CheckGuard(ctx context.Context, guard common.Address) error {
+	code, err := gm.client.CodeAt(ctx, guard, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get code at guard %s: %w", guard.Hex(), err)
+	}
+	if len(code) == 0 {
+		return fmt.Errorf("guard %s is not a contract", guard.Hex())
+	}
+
+	supported, err := gm.supportsGuardInterface(ctx, guard)
+	if err != nil {
+		return fmt.Errorf("failed to check ERC-165 support of guard %s: %w", guard.Hex(), err)
+	}
+	if !supported {
+		return fmt.Errorf("guard %s does not support the Guard interface (0x%x)", guard.Hex(), GuardInterfaceID)
+	}
+
+	if err := gm.SimulateGuard(ctx, guard); err != nil {
+		return fmt.Errorf("failed to simulate a no-op Safe transaction with guard %s: %w", guard.Hex(), err)
+	}
+
+	return nil
+}
+
+// supportsGuardInterface performs the ERC-165 detection for the Guard interface
+func (gm *GuardManager) supportsGuardInterface(ctx context.Context, guard common.Address) (bool, error) {
+	checks := []struct {
+		interfaceID [4]byte
+		want        bool
+	}{
+		{erc165InterfaceID, true},
+		{[4]byte{0xff, 0xff, 0xff, 0xff}, false},
+		{GuardInterfaceID, true},
+	}
+
+	parsedABI, err := abi.JSON(strings.NewReader(erc165ABI))
+	if err != nil {
+		return false, fmt.Errorf("failed to parse ERC-165 ABI: %w", err)
+	}
+
+	for _, check := range checks {
+		callData, err := parsedABI.Pack("supportsInterface", check.interfaceID)
+		if err != nil {
+			return false, fmt.Errorf("failed to pack supportsInterface call: %w", err)
+		}
+
+		result, err := gm.client.CallContract(ctx, ethereum.CallMsg{To: &guard, Data: callData}, nil)
+		if err != nil && !isExecutionRevert(err) {
+			return false, fmt.Errorf("failed to call supportsInterface(0x%x): %w", check.interfaceID, err)
+		}
+		if err != nil || len(result) < 32 {
+			// Contracts without ERC-165 revert or return nothing
+			return false, nil
+		}
+
+		supported := new(big.Int).SetBytes(result[:32]).Sign() != 0
+		if supported != check.want {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// isExecutionRevert reports whether an eth_call error is a revert of the called
+// contract rather than a transport or node failure
+func isExecutionRevert(err error) bool {
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) && rpcErr.ErrorCode() == 3 {
+		return true
+	}
+
+	message := strings.ToLower(err.Error())
+	return strings.Contains(message, "execution reverted") || strings.Contains(message, "invalid opcode")
+}
+
+// SimulateGuard simulates a no-op Safe transaction (a zero value call to the Safe itself)
+// with the guard installed. The simulation uses eth_call state overrides to set the guard
+// and a threshold of 1, and signs with an approved-hash signature of the first owner.
+func (gm *GuardManager) SimulateGuard(ctx context.Context, guard common.Address) error {
+	if gm.rpcClient == nil {
+		return fmt.Errorf("guard simulation requires an RPC client (use NewGuardManagerWithRPC or set Unsafe)")
+	}
+
+	safeContract, err := contracts.NewSafeContract(gm.safeAddress, gm.client)
+	if err != nil {
+		return fmt.Errorf("failed to create Safe contract instance: %w", err)
+	}
+
+	owners, err := safeContract.GetOwners(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get owners: %w", err)
+	}
+	if len(owners) == 0 {
+		return fmt.Errorf("safe has no owners")
+	}
+	owner := owners[0]
+
+	// Approved-hash signature (v = 1): valid when msg.sender is the owner
+	signature := make([]byte, 65)
+	copy(signature[12:32], owner.Bytes())
+	signature[64] = 1
+
+	execABI, err := utils.SafeContractMetaData.GetAbi()
+	if err != nil {
+		return fmt.Errorf("failed to get Safe ABI: %w", err)
+	}
+
+	zero := big.NewInt(0)
+	callData, err := execABI.Pack(
+		"execTransaction",
+		gm.safeAddress,   // to
+		zero,             // value
+		[]byte{},         // data
+		uint8(0),         // operation
+		zero,             // safeTxGas
+		zero,             // baseGas
+		zero,             // gasPrice
+		common.Address{}, // gasToken
+		common.Address{}, // refundReceiver
+		signature,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to pack execTransaction call: %w", err)
+	}
+
+	callArgs := map[string]interface{}{
+		"from": owner,
+		"to":   gm.safeAddress,
+		"data": hexutil.Bytes(callData),
+	}
+
+	overrides := map[common.Address]stateOverride{
+		gm.safeAddress: {
+			StateDiff: map[common.Hash]common.Hash{
+				GuardStorageSlot:  common.BytesToHash(guard.Bytes()),
+				safeThresholdSlot: common.BigToHash(common.Big1),
+			},
+		},
+	}
+
+	var result hexutil.Bytes
+	if err := gm.rpcClient.CallContext(ctx, &result, "eth_call", callArgs, "latest", overrides); err != nil {
+		return fmt.Errorf("simulation reverted: %w", err)
+	}
+
+	outputs, err := execABI.Unpack("execTransaction", result)
+	if err != nil {
+		return fmt.Errorf("failed to unpack simulation result: %w", err)
+	}
+	if success, ok := outputs[0].(bool); !ok || !success {
+		return fmt.Errorf("simulated transaction did not succeed")
+	}
+
+	return nil
+}
+
+// stateOverride is an eth_call account override. Only the storage diff is set so that
+// the code, balance and nonce of the account are left untouched.
+type stateOverride struct {
+	StateDiff map[common.Hash]common.Hash `json:"stateDiff,omitempty"`
+}
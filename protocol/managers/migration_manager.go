@@ -0,0 +1,328 @@
+package managers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/vikkkko/safe-core-sdk-golang/protocol/contracts"
+	"github.com/vikkkko/safe-core-sdk-golang/types"
+)
+
+// SafeSingletonDeployment lists the official singleton and fallback handler
+// deployments of a Safe version. The canonical address comes first.
+type SafeSingletonDeployment struct {
+	Version          types.SafeVersion `json:"version"`
+	Singletons       []common.Address  `json:"singletons"`
+	L2Singletons     []common.Address  `json:"l2Singletons"`
+	FallbackHandlers []common.Address  `json:"fallbackHandlers"`
+	Migration        common.Address    `json:"migration"` // SafeMigration contract targeting this version (zero if none)
+}
+
+// SafeSingletonDeployments is the deployments registry used for migrations
+var SafeSingletonDeployments = map[types.SafeVersion]SafeSingletonDeployment{
+	types.SafeVersion130: {
+		Version: types.SafeVersion130,
+		Singletons: []common.Address{
+			common.HexToAddress("0xd9Db270c1B5E3Bd161E8c8503c55cEABeE709552"),
+			common.HexToAddress("0x69f4D1788e39c87893C980c06EdF4b7f686e2938"), // eip155
+		},
+		L2Singletons: []common.Address{
+			common.HexToAddress("0x3E5c63644E683549055b9Be8653de26E0B4CD36E"),
+			common.HexToAddress("0xfb1bffC9d739B8D520DaF37dF666da4C687191EA"), // eip155
+		},
+		FallbackHandlers: []common.Address{
+			common.HexToAddress("0xf48f2B2d2a534e402487b3ee7C18c33Aec0Fe5e4"),
+			common.HexToAddress("0x017062a1dE2FE6b99BE3d9d37841FeD19F573804"), // eip155
+		},
+	},
+	types.SafeVersion141: {
+		Version:          types.SafeVersion141,
+		Singletons:       []common.Address{common.HexToAddress("0x41675C099F32341bf84BFc5382aF534df5C7461a")},
+		L2Singletons:     []common.Address{common.HexToAddress("0x29fcB43b46531BcA003ddC8FCB67FFE91900C762")},
+		FallbackHandlers: []common.Address{common.HexToAddress("0xfd0732Dc9E303f09fCEf3a7388Ad10A83459Ec99")},
+		Migration:        common.HexToAddress("0x526643F69b81B008F46d95CD5ced5eC0edFFDaC6"),
+	},
+}
+
+// migrationSourceVersions are the versions whose storage layout SafeMigration supports
+var migrationSourceVersions = map[types.SafeVersion]bool{
+	types.SafeVersion130: true,
+	types.SafeVersion141: true,
+}
+
+// LookupSafeSingleton finds the version of a singleton in the deployments registry
+func LookupSafeSingleton(singleton common.Address) (version types.SafeVersion, isL2 bool, ok bool) {
+	for version, deployment := range SafeSingletonDeployments {
+		if containsAddress(deployment.Singletons, singleton) {
+			return version, false, true
+		}
+		if containsAddress(deployment.L2Singletons, singleton) {
+			return version, true, true
+		}
+	}
+	return "", false, false
+}
+
+// isKnownFallbackHandler reports whether the handler is an official handler of any version
+func isKnownFallbackHandler(handler common.Address) bool {
+	for _, deployment := range SafeSingletonDeployments {
+		if containsAddress(deployment.FallbackHandlers, handler) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsAddress(addresses []common.Address, target common.Address) bool {
+	for _, address := range addresses {
+		if address == target {
+			return true
+		}
+	}
+	return false
+}
+
+// MigrationManager manages Safe singleton migrations
+type MigrationManager struct {
+	client      *ethclient.Client
+	safeAddress common.Address
+}
+
+// NewMigrationManager creates a new migration manager
+func NewMigrationManager(client *ethclient.Client, safeAddress common.Address) *MigrationManager {
+	return &MigrationManager{
+		client:      client,
+		safeAddress: safeAddress,
+	}
+}
+
+// MigrationOptions represents optional parameters for a singleton migration
+type MigrationOptions struct {
+	L2                    *bool  `json:"l2,omitempty"`                    // Target the L2 singleton (defaults to the current flavour)
+	UpdateFallbackHandler *bool  `json:"updateFallbackHandler,omitempty"` // Swap the fallback handler (defaults to swapping official handlers only)
+	MigrationAddress      string `json:"migrationAddress,omitempty"`      // SafeMigration contract (defaults to the registry address)
+}
+
+// SafeMigrationPlan describes a pre-checked singleton migration
+type SafeMigrationPlan struct {
+	FromVersion            types.SafeVersion `json:"fromVersion"`
+	FromL2                 bool              `json:"fromL2"`
+	ToVersion              types.SafeVersion `json:"toVersion"`
+	ToL2                   bool              `json:"toL2"`
+	CurrentSingleton       common.Address    `json:"currentSingleton"`
+	TargetSingleton        common.Address    `json:"targetSingleton"`
+	MigrationAddress       common.Address    `json:"migrationAddress"`
+	Method                 string            `json:"method"`
+	UpdatesFallbackHandler bool              `json:"updatesFallbackHandler"`
+	FallbackHandler        common.Address    `json:"fallbackHandler"` // Handler set by the migration (if updated)
+	Data                   []byte            `json:"data"`            // Calldata to delegatecall on the migration contract
+}
+
+// GetSingleton returns the singleton the Safe proxy currently delegates to
+func (mm *MigrationManager) GetSingleton(ctx context.Context) (common.Address, error) {
+	safeContract, err := contracts.NewSafeContract(mm.safeAddress, mm.client)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to create Safe contract instance: %w", err)
+	}
+
+	return safeContract.GetSingleton(ctx)
+}
+
+// PrepareMigration pre-checks a migration to the target version and encodes the
+// SafeMigration call. The returned calldata must be executed as a delegatecall.
+func (mm *MigrationManager) PrepareMigration(ctx context.Context, targetVersion types.SafeVersion, options *MigrationOptions) (*SafeMigrationPlan, error) {
+	if options == nil {
+		options = &MigrationOptions{}
+	}
+
+	currentSingleton, err := mm.GetSingleton(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get singleton: %w", err)
+	}
+
+	fromVersion, fromL2, ok := LookupSafeSingleton(currentSingleton)
+	if !ok {
+		return nil, fmt.Errorf("singleton %s is not in the deployments registry", currentSingleton.Hex())
+	}
+	if !migrationSourceVersions[fromVersion] {
+		return nil, fmt.Errorf("storage layout of Safe %s is not compatible with SafeMigration", fromVersion)
+	}
+
+	safeContract, err := contracts.NewSafeContract(mm.safeAddress, mm.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Safe contract instance: %w", err)
+	}
+
+	onChainVersion, err := safeContract.VERSION(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Safe version: %w", err)
+	}
+	if types.SafeVersion(onChainVersion) != fromVersion {
+		return nil, fmt.Errorf("Safe reports version %s but singleton %s is registered as %s", onChainVersion, currentSingleton.Hex(), fromVersion)
+	}
+
+	target, ok := SafeSingletonDeployments[targetVersion]
+	if !ok {
+		return nil, fmt.Errorf("Safe version %s is not in the deployments registry", targetVersion)
+	}
+
+	toL2 := fromL2
+	if options.L2 != nil {
+		toL2 = *options.L2
+	}
+
+	migrationAddress := target.Migration
+	if options.MigrationAddress != "" {
+		if !common.IsHexAddress(options.MigrationAddress) {
+			return nil, fmt.Errorf("invalid migration address: %s", options.MigrationAddress)
+		}
+		migrationAddress = common.HexToAddress(options.MigrationAddress)
+	}
+	if migrationAddress == (common.Address{}) {
+		return nil, fmt.Errorf("no SafeMigration contract registered for version %s", targetVersion)
+	}
+
+	migration, err := contracts.NewSafeMigration(migrationAddress, mm.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create SafeMigration instance: %w", err)
+	}
+
+	code, err := mm.client.CodeAt(ctx, migrationAddress, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get code at migration contract: %w", err)
+	}
+	if len(code) == 0 {
+		return nil, fmt.Errorf("migration contract %s has no code", migrationAddress.Hex())
+	}
+
+	callOpts := &bind.CallOpts{Context: ctx}
+	var targetSingleton common.Address
+	if toL2 {
+		targetSingleton, err = migration.SAFEL2SINGLETON(callOpts)
+	} else {
+		targetSingleton, err = migration.SAFESINGLETON(callOpts)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get migration target singleton: %w", err)
+	}
+
+	registered := target.Singletons
+	if toL2 {
+		registered = target.L2Singletons
+	}
+	if !containsAddress(registered, targetSingleton) {
+		return nil, fmt.Errorf("migration target singleton %s is not a registered %s deployment", targetSingleton.Hex(), targetVersion)
+	}
+	if targetSingleton == currentSingleton {
+		return nil, fmt.Errorf("Safe already uses singleton %s", currentSingleton.Hex())
+	}
+
+	singletonCode, err := mm.client.CodeAt(ctx, targetSingleton, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get code at target singleton: %w", err)
+	}
+	if len(singletonCode) == 0 {
+		return nil, fmt.Errorf("target singleton %s is not deployed on this chain", targetSingleton.Hex())
+	}
+
+	fallbackHandler, err := migration.SAFEFALLBACKHANDLER(callOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get migration fallback handler: %w", err)
+	}
+
+	// Only official handlers are swapped by default so custom handlers survive
+	updateFallbackHandler := false
+	if options.UpdateFallbackHandler != nil {
+		updateFallbackHandler = *options.UpdateFallbackHandler
+	} else {
+		currentHandler, err := safeContract.GetFallbackHandler(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get fallback handler: %w", err)
+		}
+		updateFallbackHandler = isKnownFallbackHandler(currentHandler) && !containsAddress(target.FallbackHandlers, currentHandler)
+	}
+	if updateFallbackHandler && !containsAddress(target.FallbackHandlers, fallbackHandler) {
+		return nil, fmt.Errorf("migration fallback handler %s is not a registered %s deployment", fallbackHandler.Hex(), targetVersion)
+	}
+
+	method := "migrateSingleton"
+	switch {
+	case toL2 && updateFallbackHandler:
+		method = "migrateL2WithFallbackHandler"
+	case toL2:
+		method = "migrateL2Singleton"
+	case updateFallbackHandler:
+		method = "migrateWithFallbackHandler"
+	}
+
+	migrationABI, err := contracts.SafeMigrationMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get SafeMigration ABI: %w", err)
+	}
+
+	data, err := migrationABI.Pack(method)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack %s call: %w", method, err)
+	}
+
+	plan := &SafeMigrationPlan{
+		FromVersion:            fromVersion,
+		FromL2:                 fromL2,
+		ToVersion:              targetVersion,
+		ToL2:                   toL2,
+		CurrentSingleton:       currentSingleton,
+		TargetSingleton:        targetSingleton,
+		MigrationAddress:       migrationAddress,
+		Method:                 method,
+		UpdatesFallbackHandler: updateFallbackHandler,
+		Data:                   data,
+	}
+	if updateFallbackHandler {
+		plan.FallbackHandler = fallbackHandler
+	}
+
+	return plan, nil
+}
+
+// VerifyMigration checks the singleton slot (and fallback handler if swapped) after execution
+func (mm *MigrationManager) VerifyMigration(ctx context.Context, plan *SafeMigrationPlan) error {
+	if plan == nil {
+		return fmt.Errorf("migration plan cannot be nil")
+	}
+
+	singleton, err := mm.GetSingleton(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get singleton: %w", err)
+	}
+	if singleton != plan.TargetSingleton {
+		return fmt.Errorf("singleton slot holds %s, expected %s", singleton.Hex(), plan.TargetSingleton.Hex())
+	}
+
+	safeContract, err := contracts.NewSafeContract(mm.safeAddress, mm.client)
+	if err != nil {
+		return fmt.Errorf("failed to create Safe contract instance: %w", err)
+	}
+
+	version, err := safeContract.VERSION(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get Safe version: %w", err)
+	}
+	if types.SafeVersion(version) != plan.ToVersion {
+		return fmt.Errorf("Safe reports version %s, expected %s", version, plan.ToVersion)
+	}
+
+	if plan.UpdatesFallbackHandler {
+		handler, err := safeContract.GetFallbackHandler(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get fallback handler: %w", err)
+		}
+		if handler != plan.FallbackHandler {
+			return fmt.Errorf("fallback handler is %s, expected %s", handler.Hex(), plan.FallbackHandler.Hex())
+		}
+	}
+
+	return nil
+}
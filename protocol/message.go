@@ -0,0 +1,192 @@
+package protocol
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/vikkkko/safe-core-sdk-golang/api"
+	"github.com/vikkkko/safe-core-sdk-golang/protocol/utils"
+	"github.com/vikkkko/safe-core-sdk-golang/types"
+)
+
+// ConfirmMessageConfig contains configuration for signing an off-chain Safe message
+type ConfirmMessageConfig struct {
+	Message   interface{}     // Message to sign: a string or an apitypes.TypedData
+	SafeAppId *int64          // Safe App proposing the message (optional)
+	APIClient *api.SafeApiKit // API client for Safe Transaction Service
+}
+
+// ConfirmMessageResult contains the result of signing a Safe message
+type ConfirmMessageResult struct {
+	MessageHash        string // Safe message hash the owners sign
+	AlreadySigned      bool   // Whether the current signer already signed
+	SignatureSubmitted bool   // Whether a new signature was submitted
+	ThresholdMet       bool   // Whether signature threshold is met
+	CurrentSignatures  int    // Current number of signatures
+	RequiredSignatures int    // Required number of signatures
+	PreparedSignature  string // Combined signature for EIP-1271 isValidSignature, set once the threshold is met
+}
+
+// HashSafeMessage returns the EIP-191 hash of a string message or the EIP-712 hash
+// of typed data
+func HashSafeMessage(message interface{}) ([]byte, error) {
+	switch m := message.(type) {
+	case string:
+		return accounts.TextHash([]byte(m)), nil
+	case apitypes.TypedData:
+		hash, _, err := apitypes.TypedDataAndHash(m)
+		if err != nil {
+			return nil, fmt.Errorf("failed to hash typed data: %w", err)
+		}
+		return hash, nil
+	case *apitypes.TypedData:
+		return HashSafeMessage(*m)
+	default:
+		return nil, fmt.Errorf("unsupported message type %T", message)
+	}
+}
+
+// GetSafeMessageHash returns the hash owners sign for a message of this Safe
+func (s *Safe) GetSafeMessageHash(message interface{}) ([]byte, error) {
+	messageHash, err := HashSafeMessage(message)
+	if err != nil {
+		return nil, err
+	}
+	return utils.CalculateMessageHash(s.GetAddress(), messageHash, big.NewInt(s.config.ChainID))
+}
+
+// ConfirmMessage signs an off-chain Safe message and collects the signature in the
+// Safe Transaction Service:
+// 1. Proposes the message with the signature if the service does not know it yet
+// 2. Otherwise adds the signature unless the current signer already signed
+// 3. Returns the combined signature once the Safe threshold is met
+func (s *Safe) ConfirmMessage(ctx context.Context, config ConfirmMessageConfig) (*ConfirmMessageResult, error) {
+	if config.Message == nil {
+		return nil, fmt.Errorf("message is required")
+	}
+
+	if config.APIClient == nil {
+		return nil, fmt.Errorf("APIClient is required")
+	}
+
+	if s.config.PrivateKey == "" {
+		return nil, fmt.Errorf("private key is required to confirm message")
+	}
+
+	privateKey, err := crypto.HexToECDSA(strings.TrimPrefix(s.config.PrivateKey, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %w", err)
+	}
+	signerAddress := crypto.PubkeyToAddress(privateKey.PublicKey)
+
+	safeMessageHash, err := s.GetSafeMessageHash(config.Message)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate message hash: %w", err)
+	}
+
+	result := &ConfirmMessageResult{MessageHash: "0x" + hex.EncodeToString(safeMessageHash)}
+
+	safeAddress := s.GetAddress().Hex()
+	safeInfo, err := config.APIClient.GetSafeInfo(ctx, safeAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Safe info: %w", err)
+	}
+	result.RequiredSignatures = safeInfo.Threshold
+
+	// 1. Fetch the message, if it was already proposed
+	message, err := config.APIClient.GetMessage(ctx, result.MessageHash)
+	var apiErr *api.APIError
+	if err != nil && !(errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound) {
+		return nil, err
+	}
+
+	if message != nil {
+		for _, confirmation := range message.Confirmations {
+			if strings.EqualFold(confirmation.Owner, signerAddress.Hex()) {
+				result.AlreadySigned = true
+				break
+			}
+		}
+		result.CurrentSignatures = len(message.Confirmations)
+	}
+
+	// 2. Sign and submit if not already signed and more signatures are required
+	if !result.AlreadySigned && result.CurrentSignatures < result.RequiredSignatures {
+		signature, err := utils.SignMessage(safeMessageHash, privateKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to sign message: %w", err)
+		}
+		signatureHex := "0x" + hex.EncodeToString(signature)
+
+		if message == nil {
+			err = config.APIClient.AddMessage(ctx, safeAddress, api.AddMessageOptions{
+				Message:   config.Message,
+				SafeAppId: config.SafeAppId,
+				Signature: signatureHex,
+			})
+		} else {
+			err = config.APIClient.AddMessageSignature(ctx, result.MessageHash, signatureHex)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to submit signature: %w", err)
+		}
+
+		result.SignatureSubmitted = true
+
+		// Fetch the confirmations including the new signature
+		message, err = config.APIClient.GetMessage(ctx, result.MessageHash)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch updated message: %w", err)
+		}
+		result.CurrentSignatures = len(message.Confirmations)
+	}
+
+	// 3. Combine the signatures once the threshold is met
+	result.ThresholdMet = result.CurrentSignatures >= result.RequiredSignatures
+	if result.ThresholdMet && message != nil {
+		if message.PreparedSignature != nil && *message.PreparedSignature != "" {
+			result.PreparedSignature = *message.PreparedSignature
+		} else {
+			preparedSignature, err := BuildMessageSignature(message.Confirmations)
+			if err != nil {
+				return nil, fmt.Errorf("failed to build prepared signature: %w", err)
+			}
+			result.PreparedSignature = preparedSignature
+		}
+	}
+
+	return result, nil
+}
+
+// BuildMessageSignature concatenates message confirmations ordered by owner address,
+// the order the Safe expects when checking signatures. Only EOA signatures are
+// supported: contract signatures need a dynamic part that is not encoded here.
+func BuildMessageSignature(confirmations []api.SafeMessageConfirmation) (string, error) {
+	signatures := make([]types.SafeSignature, 0, len(confirmations))
+	for _, confirmation := range confirmations {
+		if confirmation.SignatureType != utils.SignatureTypeEOA {
+			return "", fmt.Errorf("unsupported signature type %q of owner %s", confirmation.SignatureType, confirmation.Owner)
+		}
+		signatures = append(signatures, types.SafeSignature{
+			Signer: confirmation.Owner,
+			Data:   confirmation.Signature,
+		})
+	}
+
+	sort.Slice(signatures, func(i, j int) bool {
+		return bytes.Compare(common.HexToAddress(signatures[i].Signer).Bytes(), common.HexToAddress(signatures[j].Signer).Bytes()) < 0
+	})
+
+	return "0x" + hex.EncodeToString(utils.BuildSignatureBytes(signatures)), nil
+}
@@ -8,8 +8,10 @@ import (
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/vikkkko/safe-core-sdk-golang/protocol/managers"
 	"github.com/vikkkko/safe-core-sdk-golang/protocol/utils"
 	"github.com/vikkkko/safe-core-sdk-golang/types"
@@ -33,22 +35,25 @@ type SafeConfigWithPredicted struct {
 
 // Safe represents a Safe Smart Account client
 type Safe struct {
-	config          SafeConfig
-	client          *ethclient.Client
-	predictedSafe   *types.PredictedSafeProps
-	contractManager *managers.ContractManager
-	ownerManager    *managers.OwnerManager
-	moduleManager   *managers.ModuleManager
-	guardManager    *managers.GuardManager
-	fallbackManager *managers.FallbackHandlerManager
+	config           SafeConfig
+	client           *ethclient.Client
+	rpcClient        *rpc.Client
+	predictedSafe    *types.PredictedSafeProps
+	contractManager  *managers.ContractManager
+	ownerManager     *managers.OwnerManager
+	moduleManager    *managers.ModuleManager
+	guardManager     *managers.GuardManager
+	fallbackManager  *managers.FallbackHandlerManager
+	migrationManager *managers.MigrationManager
 }
 
 // NewSafe creates a new Safe client for an existing Safe
 func NewSafe(config SafeConfig) (*Safe, error) {
-	client, err := ethclient.Dial(config.RpcURL)
+	rpcClient, err := rpc.Dial(config.RpcURL)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to Ethereum client: %w", err)
 	}
+	client := ethclient.NewClient(rpcClient)
 
 	// Validate the Safe address
 	if !common.IsHexAddress(config.SafeAddress) {
@@ -63,24 +68,27 @@ func NewSafe(config SafeConfig) (*Safe, error) {
 	safe := &Safe{
 		config:          config,
 		client:          client,
+		rpcClient:       rpcClient,
 		contractManager: contractManager,
 	}
 
 	// Initialize managers
 	safe.ownerManager = managers.NewOwnerManager(safe.client, common.HexToAddress(config.SafeAddress))
 	safe.moduleManager = managers.NewModuleManager(safe.client, common.HexToAddress(config.SafeAddress))
-	safe.guardManager = managers.NewGuardManager(safe.client, common.HexToAddress(config.SafeAddress))
+	safe.guardManager = managers.NewGuardManagerWithRPC(safe.rpcClient, common.HexToAddress(config.SafeAddress))
 	safe.fallbackManager = managers.NewFallbackHandlerManager(safe.client, common.HexToAddress(config.SafeAddress))
+	safe.migrationManager = managers.NewMigrationManager(safe.client, common.HexToAddress(config.SafeAddress))
 
 	return safe, nil
 }
 
 // NewSafeWithPredicted creates a new Safe client for a predicted (not yet deployed) Safe
 func NewSafeWithPredicted(config SafeConfigWithPredicted) (*Safe, error) {
-	client, err := ethclient.Dial(config.RpcURL)
+	rpcClient, err := rpc.Dial(config.RpcURL)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to Ethereum client: %w", err)
 	}
+	client := ethclient.NewClient(rpcClient)
 
 	contractManager, err := managers.NewContractManager(client, big.NewInt(config.ChainID))
 	if err != nil {
@@ -95,6 +103,7 @@ func NewSafeWithPredicted(config SafeConfigWithPredicted) (*Safe, error) {
 			PrivateKey:  config.PrivateKey,
 		},
 		client:          client,
+		rpcClient:       rpcClient,
 		predictedSafe:   &config.Predicted,
 		contractManager: contractManager,
 	}
@@ -102,8 +111,9 @@ func NewSafeWithPredicted(config SafeConfigWithPredicted) (*Safe, error) {
 	// Initialize managers
 	safe.ownerManager = managers.NewOwnerManager(safe.client, common.HexToAddress(config.Predicted.SafeAddress))
 	safe.moduleManager = managers.NewModuleManager(safe.client, common.HexToAddress(config.Predicted.SafeAddress))
-	safe.guardManager = managers.NewGuardManager(safe.client, common.HexToAddress(config.Predicted.SafeAddress))
+	safe.guardManager = managers.NewGuardManagerWithRPC(safe.rpcClient, common.HexToAddress(config.Predicted.SafeAddress))
 	safe.fallbackManager = managers.NewFallbackHandlerManager(safe.client, common.HexToAddress(config.Predicted.SafeAddress))
+	safe.migrationManager = managers.NewMigrationManager(safe.client, common.HexToAddress(config.Predicted.SafeAddress))
 
 	return safe, nil
 }
@@ -168,6 +178,38 @@ func (s *Safe) IsOwner(ctx context.Context, address common.Address) (bool, error
 	return s.ownerManager.IsOwner(ctx, address)
 }
 
+// GetDelayManager returns a manager for a Zodiac Delay modifier attached to the Safe
+func (s *Safe) GetDelayManager(delayAddress common.Address) *managers.DelayManager {
+	return managers.NewDelayManager(s.client, s.moduleManager, delayAddress)
+}
+
+// CreateMigrationTransaction creates a Safe transaction that delegatecalls the
+// official SafeMigration contract to move the Safe to the target singleton
+func (s *Safe) CreateMigrationTransaction(ctx context.Context, targetVersion types.SafeVersion, options *managers.MigrationOptions) (*types.SafeTransaction, *managers.SafeMigrationPlan, error) {
+	plan, err := s.migrationManager.PrepareMigration(ctx, targetVersion, options)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to prepare migration: %w", err)
+	}
+
+	operation := types.DelegateCall
+	tx, err := s.CreateTransaction(ctx, types.SafeTransactionDataPartial{
+		To:        plan.MigrationAddress.Hex(),
+		Value:     "0",
+		Data:      hexutil.Encode(plan.Data),
+		Operation: &operation,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create migration transaction: %w", err)
+	}
+
+	return tx, plan, nil
+}
+
+// VerifyMigration checks that an executed migration left the Safe on the planned singleton
+func (s *Safe) VerifyMigration(ctx context.Context, plan *managers.SafeMigrationPlan) error {
+	return s.migrationManager.VerifyMigration(ctx, plan)
+}
+
 // GetSafeInfo returns complete information about the Safe
 func (s *Safe) GetSafeInfo(ctx context.Context) (*types.SafeInfo, error) {
 	address := s.GetAddress()
@@ -216,6 +258,11 @@ func (s *Safe) GetSafeInfo(ctx context.Context) (*types.SafeInfo, error) {
 		return nil, fmt.Errorf("failed to get guard: %w", err)
 	}
 
+	singleton, err := s.migrationManager.GetSingleton(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get singleton: %w", err)
+	}
+
 	// Get version (placeholder for now)
 	version := string(types.DefaultSafeVersion)
 
@@ -224,7 +271,7 @@ func (s *Safe) GetSafeInfo(ctx context.Context) (*types.SafeInfo, error) {
 		Nonce:           nonce,
 		Threshold:       threshold,
 		Owners:          ownerStrings,
-		MasterCopy:      "", // TODO: Implement getting master copy
+		MasterCopy:      singleton.Hex(),
 		Modules:         moduleStrings,
 		FallbackHandler: fallbackHandler.Hex(),
 		Guard:           guard.Hex(),
@@ -246,6 +293,44 @@ func (s *Safe) CreateTransaction(ctx context.Context, txData types.SafeTransacti
 	}, nil
 }
 
+// CreateMultiSendTransaction creates a Safe transaction running txs in order. A
+// single transaction is called directly; several are batched into one
+// MultiSendCallOnly delegatecall. A nil nonce uses the Safe's current nonce.
+func (s *Safe) CreateMultiSendTransaction(ctx context.Context, txs []types.MetaTransactionData, nonce *uint64) (*types.SafeTransaction, error) {
+	if len(txs) == 0 {
+		return nil, fmt.Errorf("no transactions to batch")
+	}
+
+	if len(txs) == 1 {
+		return s.CreateTransaction(ctx, types.SafeTransactionDataPartial{
+			To:        txs[0].To,
+			Value:     txs[0].Value,
+			Data:      txs[0].Data,
+			Operation: txs[0].Operation,
+			Nonce:     nonce,
+		})
+	}
+
+	multiSendAddress, err := s.configurationMultiSendAddress("")
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := utils.CreateMultiSendData(txs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create MultiSend data: %w", err)
+	}
+
+	operation := types.DelegateCall
+	return s.CreateTransaction(ctx, types.SafeTransactionDataPartial{
+		To:        multiSendAddress.Hex(),
+		Value:     "0",
+		Data:      hexutil.Encode(data),
+		Operation: &operation,
+		Nonce:     nonce,
+	})
+}
+
 // standardizeSafeTransactionData fills in missing fields in transaction data
 func (s *Safe) standardizeSafeTransactionData(ctx context.Context, txData types.SafeTransactionDataPartial) (*types.SafeTransactionData, error) {
 	// Set default operation type if not specified
@@ -0,0 +1,470 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	defaultSubscribePollInterval  = 12 * time.Second
+	defaultSubscribeConfirmations = 12
+	defaultSubscribeMaxBlockRange = 2000
+	defaultSubscribeBufferSize    = 64
+)
+
+// BlockCheckpointStore persists the last confirmed block whose events were fully delivered
+type BlockCheckpointStore interface {
+	LoadCheckpoint(ctx context.Context) (block uint64, ok bool, err error)
+	SaveCheckpoint(ctx context.Context, block uint64) error
+}
+
+// MemoryCheckpointStore is an in-memory BlockCheckpointStore
+type MemoryCheckpointStore struct {
+	mu    sync.Mutex
+	block uint64
+	ok    bool
+}
+
+// LoadCheckpoint returns the saved block, if any
+func (m *MemoryCheckpointStore) LoadCheckpoint(ctx context.Context) (uint64, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.block, m.ok, nil
+}
+
+// SaveCheckpoint stores the block
+func (m *MemoryCheckpointStore) SaveCheckpoint(ctx context.Context, block uint64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.block, m.ok = block, true
+	return nil
+}
+
+// SubscribeOptions represents options for Safe.Subscribe
+type SubscribeOptions struct {
+	EventTypes    []SafeEventType      // Events to deliver (all supported events if empty)
+	FromBlock     *uint64              // First block to deliver (defaults to checkpoint + 1, then to the chain head)
+	Checkpoint    BlockCheckpointStore // Where progress is loaded from and saved to (optional)
+	ForcePolling  bool                 // Poll even if the RPC supports subscriptions
+	PollInterval  time.Duration        // Delay between polls (default 12s)
+	Confirmations uint64               // Blocks re-checked for reorgs while polling and kept out of checkpoints (default 12)
+	MaxBlockRange uint64               // Maximum eth_getLogs range (default 2000)
+	BufferSize    int                  // Event channel buffer (default 64)
+}
+
+// SafeSubscription streams decoded Safe events
+type SafeSubscription struct {
+	events chan *SafeEvent
+	errs   chan error
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// Events returns the event channel. It is closed when the subscription ends.
+func (s *SafeSubscription) Events() <-chan *SafeEvent {
+	return s.events
+}
+
+// Err returns non-fatal errors such as undecodable logs or failed polls. It is
+// closed together with the event channel.
+func (s *SafeSubscription) Err() <-chan error {
+	return s.errs
+}
+
+// Unsubscribe stops the subscription and waits for it to shut down
+func (s *SafeSubscription) Unsubscribe() {
+	s.cancel()
+	<-s.done
+}
+
+// Subscribe streams Safe events over a websocket log subscription, falling back
+// to polling when the RPC does not support notifications. Events already
+// delivered may be redelivered after a resume, so consumers should key on
+// TxHash and LogIndex. Checkpoints trail the head by Confirmations blocks, so a
+// resume replays the unconfirmed blocks from the canonical chain; events a reorg
+// removed while the subscription was stopped are not re-sent as Removed.
+func (s *Safe) Subscribe(ctx context.Context, opts *SubscribeOptions) (*SafeSubscription, error) {
+	if opts == nil {
+		opts = &SubscribeOptions{}
+	}
+
+	query, err := s.eventFilterQuery(opts.EventTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	start, err := s.subscriptionStartBlock(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	bufferSize := opts.BufferSize
+	if bufferSize <= 0 {
+		bufferSize = defaultSubscribeBufferSize
+	}
+
+	subCtx, cancel := context.WithCancel(ctx)
+	w := &safeEventWatcher{
+		safe:   s,
+		opts:   opts,
+		query:  query,
+		next:   start,
+		events: make(chan *SafeEvent, bufferSize),
+		errs:   make(chan error, bufferSize),
+		blocks: make(map[uint64]*watchedBlock),
+	}
+	sub := &SafeSubscription{
+		events: w.events,
+		errs:   w.errs,
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+
+	go func() {
+		defer close(sub.done)
+		defer close(w.errs)
+		defer close(w.events)
+		w.run(subCtx)
+	}()
+
+	return sub, nil
+}
+
+// eventFilterQuery builds the log filter for the requested event types
+func (s *Safe) eventFilterQuery(eventTypes []SafeEventType) (ethereum.FilterQuery, error) {
+	if len(eventTypes) == 0 {
+		for eventType := range safeEventFactories {
+			eventTypes = append(eventTypes, eventType)
+		}
+	}
+
+	topics := make([]common.Hash, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		if _, ok := safeEventFactories[eventType]; !ok {
+			return ethereum.FilterQuery{}, fmt.Errorf("unsupported Safe event: %s", eventType)
+		}
+		topic, err := SafeEventTopic(eventType)
+		if err != nil {
+			return ethereum.FilterQuery{}, err
+		}
+		topics = append(topics, topic)
+	}
+
+	return ethereum.FilterQuery{
+		Addresses: []common.Address{s.GetAddress()},
+		Topics:    [][]common.Hash{topics},
+	}, nil
+}
+
+// subscriptionStartBlock resolves the first block to deliver
+func (s *Safe) subscriptionStartBlock(ctx context.Context, opts *SubscribeOptions) (uint64, error) {
+	if opts.FromBlock != nil {
+		return *opts.FromBlock, nil
+	}
+
+	if opts.Checkpoint != nil {
+		block, ok, err := opts.Checkpoint.LoadCheckpoint(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("failed to load checkpoint: %w", err)
+		}
+		if ok {
+			return block + 1, nil
+		}
+	}
+
+	head, err := s.client.BlockNumber(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get block number: %w", err)
+	}
+	return head + 1, nil
+}
+
+// watchedBlock remembers the events delivered for a block still inside the reorg window
+type watchedBlock struct {
+	hash   common.Hash
+	events []*SafeEvent
+}
+
+// safeEventWatcher drives a subscription
+type safeEventWatcher struct {
+	safe   *Safe
+	opts   *SubscribeOptions
+	query  ethereum.FilterQuery
+	next   uint64 // next block to fetch
+	events chan *SafeEvent
+	errs   chan error
+	blocks map[uint64]*watchedBlock
+	saved  uint64 // last block saved to the checkpoint store, plus one
+}
+
+func (w *safeEventWatcher) run(ctx context.Context) {
+	if !w.opts.ForcePolling {
+		err := w.stream(ctx)
+		if err == nil || ctx.Err() != nil {
+			return
+		}
+		if !errors.Is(err, rpc.ErrNotificationsUnsupported) {
+			w.report(fmt.Errorf("log subscription failed, falling back to polling: %w", err))
+		}
+	}
+
+	w.poll(ctx)
+}
+
+// stream backfills up to the head, then follows a log subscription.
+// It returns nil only when the context is cancelled.
+func (w *safeEventWatcher) stream(ctx context.Context) error {
+	logs := make(chan gethtypes.Log, cap(w.events))
+	sub, err := w.safe.client.SubscribeFilterLogs(ctx, w.query, logs)
+	if err != nil {
+		return err
+	}
+	defer sub.Unsubscribe()
+
+	head, err := w.safe.client.BlockNumber(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get block number: %w", err)
+	}
+	if err := w.fetch(ctx, head); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-sub.Err():
+			if err == nil {
+				err = fmt.Errorf("subscription closed")
+			}
+			return err
+		case log := <-logs:
+			// Skip logs the backfill already delivered
+			if log.BlockNumber < w.next && !log.Removed {
+				continue
+			}
+			if log.BlockNumber > w.next && !log.Removed {
+				w.next = log.BlockNumber
+				w.checkpoint(ctx, log.BlockNumber)
+			}
+			w.deliverLog(ctx, log)
+			w.prune(log.BlockNumber)
+		}
+	}
+}
+
+// poll fetches logs on an interval, re-checking recent blocks for reorgs
+func (w *safeEventWatcher) poll(ctx context.Context) {
+	interval := w.opts.PollInterval
+	if interval <= 0 {
+		interval = defaultSubscribePollInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := w.pollOnce(ctx); err != nil && ctx.Err() == nil {
+			w.report(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *safeEventWatcher) pollOnce(ctx context.Context) error {
+	if err := w.detectReorg(ctx); err != nil {
+		return err
+	}
+
+	head, err := w.safe.client.BlockNumber(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get block number: %w", err)
+	}
+
+	return w.fetch(ctx, head)
+}
+
+// fetch delivers logs from w.next through head in bounded ranges
+func (w *safeEventWatcher) fetch(ctx context.Context, head uint64) error {
+	maxRange := w.opts.MaxBlockRange
+	if maxRange == 0 {
+		maxRange = defaultSubscribeMaxBlockRange
+	}
+
+	for w.next <= head {
+		to := w.next + maxRange - 1
+		if to > head {
+			to = head
+		}
+
+		query := w.query
+		query.FromBlock = new(big.Int).SetUint64(w.next)
+		query.ToBlock = new(big.Int).SetUint64(to)
+
+		logs, err := w.safe.client.FilterLogs(ctx, query)
+		if err != nil {
+			return fmt.Errorf("failed to filter logs from block %d to %d: %w", w.next, to, err)
+		}
+
+		for _, log := range logs {
+			if !w.deliverLog(ctx, log) {
+				return ctx.Err()
+			}
+		}
+
+		// Remember the tip so a reorg of blocks without events is still noticed
+		if _, ok := w.blocks[to]; !ok {
+			header, err := w.safe.client.HeaderByNumber(ctx, new(big.Int).SetUint64(to))
+			if err != nil {
+				return fmt.Errorf("failed to get header %d: %w", to, err)
+			}
+			w.blocks[to] = &watchedBlock{hash: header.Hash()}
+		}
+
+		w.next = to + 1
+		w.checkpoint(ctx, head)
+		w.prune(head)
+	}
+
+	return nil
+}
+
+// detectReorg compares remembered block hashes with the canonical chain. Events
+// of replaced blocks are re-emitted with Removed set and the blocks refetched.
+func (w *safeEventWatcher) detectReorg(ctx context.Context) error {
+	if len(w.blocks) == 0 {
+		return nil
+	}
+
+	numbers := make([]uint64, 0, len(w.blocks))
+	for number := range w.blocks {
+		numbers = append(numbers, number)
+	}
+	sort.Slice(numbers, func(i, j int) bool { return numbers[i] > numbers[j] })
+
+	rewindTo := numbers[len(numbers)-1]
+	for _, number := range numbers {
+		header, err := w.safe.client.HeaderByNumber(ctx, new(big.Int).SetUint64(number))
+		if err != nil && !errors.Is(err, ethereum.NotFound) {
+			return fmt.Errorf("failed to get header %d: %w", number, err)
+		}
+		if err == nil && header.Hash() == w.blocks[number].hash {
+			// Blocks before a canonical block are canonical too
+			rewindTo = number + 1
+			break
+		}
+	}
+
+	if rewindTo >= w.next {
+		return nil
+	}
+
+	for _, number := range numbers {
+		if number < rewindTo {
+			continue
+		}
+		for _, event := range w.blocks[number].events {
+			removed := *event
+			removed.Removed = true
+			removed.Raw.Removed = true
+			if !w.send(ctx, &removed) {
+				return ctx.Err()
+			}
+		}
+		delete(w.blocks, number)
+	}
+
+	w.next = rewindTo
+	return nil
+}
+
+func (w *safeEventWatcher) confirmations() uint64 {
+	if w.opts.Confirmations == 0 {
+		return defaultSubscribeConfirmations
+	}
+	return w.opts.Confirmations
+}
+
+// prune forgets blocks that are deeper than the reorg window
+func (w *safeEventWatcher) prune(head uint64) {
+	confirmations := w.confirmations()
+	for number := range w.blocks {
+		if number+confirmations < head {
+			delete(w.blocks, number)
+		}
+	}
+}
+
+// deliverLog decodes and sends a log, returning false if the context ended
+func (w *safeEventWatcher) deliverLog(ctx context.Context, log gethtypes.Log) bool {
+	event, err := DecodeSafeLog(log)
+	if err != nil {
+		w.report(err)
+		return ctx.Err() == nil
+	}
+
+	if !log.Removed {
+		block, ok := w.blocks[log.BlockNumber]
+		if !ok || block.hash != log.BlockHash {
+			block = &watchedBlock{hash: log.BlockHash}
+			w.blocks[log.BlockNumber] = block
+		}
+		block.events = append(block.events, event)
+	}
+
+	return w.send(ctx, event)
+}
+
+func (w *safeEventWatcher) send(ctx context.Context, event *SafeEvent) bool {
+	select {
+	case w.events <- event:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// report forwards a non-fatal error without blocking the watcher
+func (w *safeEventWatcher) report(err error) {
+	select {
+	case w.errs <- err:
+	default:
+	}
+}
+
+// checkpoint saves the last confirmed block at the given head whose events were
+// delivered. Unconfirmed blocks are left out so that a resume refetches them.
+func (w *safeEventWatcher) checkpoint(ctx context.Context, head uint64) {
+	if w.opts.Checkpoint == nil || head < w.confirmations() || w.next == 0 {
+		return
+	}
+
+	block := head - w.confirmations()
+	if block >= w.next {
+		block = w.next - 1
+	}
+	if block+1 <= w.saved {
+		return
+	}
+
+	if err := w.opts.Checkpoint.SaveCheckpoint(ctx, block); err != nil {
+		w.report(fmt.Errorf("failed to save checkpoint: %w", err))
+		return
+	}
+	w.saved = block + 1
+}
@@ -0,0 +1,50 @@
+package utils
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// PaymentAccountCreationCode is the PaymentAccount creation code deployed by
+// EnterpriseWallet.createPaymentAccount (abi/EnterpriseWallet_full.json)
+const PaymentAccountCreationCode = "0x608060405234801561000f575f80fd5b5060017f9b779b17422d0df92223018b32b4d1fa46e071723d6817e2486d003becc55f0055610c40806100415f395ff3fe608060405260043610610083575f3560e01c8063741606b511610055578063741606b514610116578063b5bf15e514610146578063e1f21c6714610165578063f18d03cc14610184578063f77c4791146101a357005b806306cb5b661461008c57806306fdde03146100ab57806333eeb147146100d5578063463fd1af146100f757005b3661008a57005b005b348015610097575f80fd5b5061008a6100a63660046108ff565b6101c0565b3480156100b6575f80fd5b506100bf610262565b6040516100cc919061091f565b60405180910390f35b3480156100e0575f80fd5b5060035460ff1660405190151581526020016100cc565b348015610102575f80fd5b5061008a61011136600461096a565b6102f2565b348015610121575f80fd5b505f546001600160a01b03165b6040516001600160a01b0390911681526020016100cc565b348015610151575f80fd5b5061008a6101603660046109f5565b6103b4565b348015610170575f80fd5b5061008a61017f366004610a14565b610425565b34801561018f575f80fd5b5061008a61019e366004610a4d565b6104e3565b3480156101ae575f80fd5b506001546001600160a01b031661012e565b5f546001600160a01b031633146101ea5760405163eeb9424760e01b815260040160405180910390fd5b6001600160a01b038116610211576040516336abb4df60e11b815260040160405180910390fd5b600180546001600160a01b038381166001600160a01b0319831681179093556040519116919082907f1c87e2bbc4e5fa5d7f6f8c44d66cb241dff224b8602eb5435ca2076d2a5c6fc2905f90a35050565b60606002805461027190610a95565b80601f016020809104026020016040519081016040528092919081815260200182805461029d90610a95565b80156102e85780601f106102bf576101008083540402835291602001916102e8565b820191905f5260205f20905b8154815290600101906020018083116102cb57829003601f168201915b5050505050905090565b600354610100900460ff161561031a5760405162dc149f60e41b815260040160405180910390fd5b6001600160a01b0382166103415760405163eeb9424760e01b815260040160405180910390fd5b6001600160a01b038116610368576040516336abb4df60e11b815260040160405180910390fd5b6002610375848683610b2e565b505f80546001600160a01b039384166001600160a01b0319918216179091556001805492909316911617905550506003805461ff001916610100179055565b5f546001600160a01b031633146103de5760405163eeb9424760e01b815260040160405180910390fd5b6003805460ff19168215159081179091556040519081527f7c115515c8d84429e161340d221c476d02fb5b2888e14ca6231e1de35d0631a09060200160405180910390a150565b60015461043a906001600160a01b03166106e8565b60035460ff161561045e576040516339e3639960e01b815260040160405180910390fd5b610466610714565b6001600160a01b03831661048d5760405163e6c4247b60e01b815260040160405180910390fd5b6001600160a01b0382166104b45760405163e6c4247b60e01b815260040160405180910390fd5b6104c86001600160a01b038416838361072f565b6104de60015f80516020610beb83398151915255565b505050565b6001546104f8906001600160a01b03166106e8565b60035460ff161561051c576040516339e3639960e01b815260040160405180910390fd5b610524610714565b6001600160a01b03831661054b5760405163162908e360e11b815260040160405180910390fd5b6001600160a01b0382166105725760405163162908e360e11b815260040160405180910390fd5b805f036105925760405163162908e360e11b815260040160405180910390fd5b6001600160a01b038416610660576001600160a01b03831630146105c95760405163162908e360e11b815260040160405180910390fd5b804710156105ea576040516313be252b60e01b815260040160405180910390fd5b5f826001600160a01b0316826040515f6040518083038185875af1925050503d805f8114610633576040519150601f19603f3d011682016040523d82523d5f602084013e610638565b606091505b505090508061065a576040516312171d8360e31b815260040160405180910390fd5b50610675565b6106756001600160a01b0385168484846107ae565b836001600160a01b0316826001600160a01b0316846001600160a01b03167f628242ab082e8da7bc0ded5fc97bd7221701125b9ea41f2cafad31e057429379846040516106c491815260200190565b60405180910390a46106e260015f80516020610beb83398151915255565b50505050565b336001600160a01b0382161461071157604051635c427cd960e01b815260040160405180910390fd5b50565b61071c6107e4565b60025f80516020610beb83398151915255565b61073b8383835f610815565b6104de5761074c83835f6001610815565b61077957604051635274afe760e01b81526001600160a01b03841660048201526024015b60405180910390fd5b6107868383836001610815565b6104de57604051635274afe760e01b81526001600160a01b0384166004820152602401610770565b6107bc848484846001610877565b6106e257604051635274afe760e01b81526001600160a01b0385166004820152602401610770565b5f80516020610beb8339815191525460020361081357604051633ee5aeb560e01b815260040160405180910390fd5b565b60405163095ea7b360e01b5f8181526001600160a01b038616600452602485905291602083604481808b5af1925060015f5114831661086b57838315161561085f573d5f823e3d81fd5b5f873b113d1516831692505b60405250949350505050565b6040516323b872dd60e01b5f8181526001600160a01b038781166004528616602452604485905291602083606481808c5af1925060015f511483166108d35783831516156108c7573d5f823e3d81fd5b5f883b113d1516831692505b604052505f60605295945050505050565b80356001600160a01b03811681146108fa575f80fd5b919050565b5f6020828403121561090f575f80fd5b610918826108e4565b9392505050565b5f6020808352835180828501525f5b8181101561094a5785810183015185820160400152820161092e565b505f604082860101526040601f19601f8301168501019250505092915050565b5f805f806060858703121561097d575f80fd5b843567ffffffffffffffff80821115610994575f80fd5b818701915087601f8301126109a7575f80fd5b8135818111156109b5575f80fd5b8860208285010111156109c6575f80fd5b6020928301965094506109dc91870190506108e4565b91506109ea604086016108e4565b905092959194509250565b5f60208284031215610a05575f80fd5b81358015158114610918575f80fd5b5f805f60608486031215610a26575f80fd5b610a2f846108e4565b9250610a3d602085016108e4565b9150604084013590509250925092565b5f805f8060808587031215610a60575f80fd5b610a69856108e4565b9350610a77602086016108e4565b9250610a85604086016108e4565b9396929550929360600135925050565b600181811c90821680610aa957607f821691505b602082108103610ac757634e487b7160e01b5f52602260045260245ffd5b50919050565b634e487b7160e01b5f52604160045260245ffd5b601f8211156104de575f81815260208120601f850160051c81016020861015610b075750805b601f850160051c820191505b81811015610b2657828155600101610b13565b505050505050565b67ffffffffffffffff831115610b4657610b46610acd565b610b5a83610b548354610a95565b83610ae1565b5f601f841160018114610b8b575f8515610b745750838201355b5f19600387901b1c1916600186901b178355610be3565b5f83815260209020601f19861690835b82811015610bbb5786850135825560209485019460019092019101610b9b565b5086821015610bd7575f1960f88860031b161c19848701351681555b505060018560011b0183555b505050505056fe9b779b17422d0df92223018b32b4d1fa46e071723d6817e2486d003becc55f00a2646970667358221220043a50796daa57ca26dc867532769f40c9395a0075ce8f5db89d84e29b845d0864736f6c63430008140033"
+
+// CollectionAccountCreationCode is the CollectionAccount creation code deployed by
+// EnterpriseWallet.createCollectionAccount (abi/EnterpriseWallet_full.json)
+const CollectionAccountCreationCode = "0x608060405234801561000f575f80fd5b5060017f9b779b17422d0df92223018b32b4d1fa46e071723d6817e2486d003becc55f0055610b29806100415f395ff3fe60806040526004361061009d575f3560e01c8063463fd1af11610062578063463fd1af146101655780636e94729814610184578063741606b5146101a0578063b5bf15e5146101bc578063f8b2cb4f146101db578063fdff9b81146101fa575f80fd5b806306ec16f8146100a857806306fdde03146100c957806333eeb147146100f3578063345ac236146101155780634556f8ea14610146575f80fd5b366100a457005b5f80fd5b3480156100b3575f80fd5b506100c76100c2366004610852565b61020e565b005b3480156100d4575f80fd5b506100dd610365565b6040516100ea9190610872565b60405180910390f35b3480156100fe575f80fd5b5060035460ff1660405190151581526020016100ea565b348015610120575f80fd5b506001546001600160a01b03165b6040516001600160a01b0390911681526020016100ea565b348015610151575f80fd5b506100c7610160366004610852565b6103f5565b348015610170575f80fd5b506100c761017f3660046108bd565b61048f565b34801561018f575f80fd5b50475b6040519081526020016100ea565b3480156101ab575f80fd5b505f546001600160a01b031661012e565b3480156101c7575f80fd5b506100c76101d6366004610948565b61052a565b3480156101e6575f80fd5b506101926101f5366004610852565b61059b565b348015610205575f80fd5b506100c7610609565b60035460ff161561023257604051635004b88160e11b815260040160405180910390fd5b61023a61074d565b6001546001600160a01b03166102635760405163416aebb560e11b815260040160405180910390fd5b6040516370a0823160e01b81523060048201525f906001600160a01b038316906370a0823190602401602060405180830381865afa1580156102a7573d5f803e3d5ffd5b505050506040513d601f19601f820116820180604052508101906102cb9190610967565b9050805f036102ed5760405163f333765d60e01b815260040160405180910390fd5b600154610307906001600160a01b03848116911683610768565b6001546040518281526001600160a01b038481169216907f7a29f18b25c2a66de077085c5729d4e931014e1dfb3e3f1de457d8cd879d40f79060200160405180910390a35061036260015f80516020610ad483398151915255565b50565b6060600280546103749061097e565b80601f01602080910402602001604051908101604052809291908181526020018280546103a09061097e565b80156103eb5780601f106103c2576101008083540402835291602001916103eb565b820191905f5260205f20905b8154815290600101906020018083116103ce57829003601f168201915b5050505050905090565b5f546001600160a01b0316331461041f5760405163eeb9424760e01b815260040160405180910390fd5b6001600160a01b0381166104465760405163416aebb560e11b815260040160405180910390fd5b600180546001600160a01b0319166001600160a01b0383169081179091556040517f3f5353429fe8f3e58bec441cad6818f6c519de3f0bed39f8ff8aed37ecf9610b905f90a250565b600354610100900460ff16156104b75760405162dc149f60e41b815260040160405180910390fd5b6001600160a01b0382166104de5760405163eeb9424760e01b815260040160405180910390fd5b60026104eb848683610a17565b505f80546001600160a01b039384166001600160a01b0319918216179091556001805492909316911617905550506003805461ff001916610100179055565b5f546001600160a01b031633146105545760405163eeb9424760e01b815260040160405180910390fd5b6003805460ff19168215159081179091556040519081527f7c115515c8d84429e161340d221c476d02fb5b2888e14ca6231e1de35d0631a09060200160405180910390a150565b6040516370a0823160e01b81523060048201525f906001600160a01b038316906370a0823190602401602060405180830381865afa1580156105df573d5f803e3d5ffd5b505050506040513d601f19601f820116820180604052508101906106039190610967565b92915050565b60035460ff161561062d57604051635004b88160e11b815260040160405180910390fd5b61063561074d565b6001546001600160a01b031661065e5760405163416aebb560e11b815260040160405180910390fd5b475f8190036106805760405163f333765d60e01b815260040160405180910390fd5b6001546040515f916001600160a01b03169083908381818185875af1925050503d805f81146106ca576040519150601f19603f3d011682016040523d82523d5f602084013e6106cf565b606091505b50509050806106f1576040516312171d8360e31b815260040160405180910390fd5b6001546040518381525f916001600160a01b0316907f7a29f18b25c2a66de077085c5729d4e931014e1dfb3e3f1de457d8cd879d40f79060200160405180910390a3505061074b60015f80516020610ad483398151915255565b565b6107556107a6565b60025f80516020610ad483398151915255565b61077583838360016107d5565b6107a157604051635274afe760e01b81526001600160a01b038416600482015260240160405180910390fd5b505050565b5f80516020610ad48339815191525460020361074b57604051633ee5aeb560e01b815260040160405180910390fd5b60405163a9059cbb60e01b5f8181526001600160a01b038616600452602485905291602083604481808b5af1925060015f5114831661082b57838315161561081f573d5f823e3d81fd5b5f873b113d1516831692505b60405250949350505050565b80356001600160a01b038116811461084d575f80fd5b919050565b5f60208284031215610862575f80fd5b61086b82610837565b9392505050565b5f6020808352835180828501525f5b8181101561089d57858101830151858201604001528201610881565b505f604082860101526040601f19601f8301168501019250505092915050565b5f805f80606085870312156108d0575f80fd5b843567ffffffffffffffff808211156108e7575f80fd5b818701915087601f8301126108fa575f80fd5b813581811115610908575f80fd5b886020828501011115610919575f80fd5b60209283019650945061092f9187019050610837565b915061093d60408601610837565b905092959194509250565b5f60208284031215610958575f80fd5b8135801515811461086b575f80fd5b5f60208284031215610977575f80fd5b5051919050565b600181811c9082168061099257607f821691505b6020821081036109b057634e487b7160e01b5f52602260045260245ffd5b50919050565b634e487b7160e01b5f52604160045260245ffd5b601f8211156107a1575f81815260208120601f850160051c810160208610156109f05750805b601f850160051c820191505b81811015610a0f578281556001016109fc565b505050505050565b67ffffffffffffffff831115610a2f57610a2f6109b6565b610a4383610a3d835461097e565b836109ca565b5f601f841160018114610a74575f8515610a5d5750838201355b5f19600387901b1c1916600186901b178355610acc565b5f83815260209020601f19861690835b82811015610aa45786850135825560209485019460019092019101610a84565b5086821015610ac0575f1960f88860031b161c19848701351681555b505060018560011b0183555b505050505056fe9b779b17422d0df92223018b32b4d1fa46e071723d6817e2486d003becc55f00a2646970667358221220599575495239b0de7eb47de7ff10d502797c8591c1b660fe4e3910bbfcb13ed864736f6c63430008140033"
+
+var (
+	paymentAccountCodeHash    = crypto.Keccak256(common.FromHex(PaymentAccountCreationCode))
+	collectionAccountCodeHash = crypto.Keccak256(common.FromHex(CollectionAccountCreationCode))
+)
+
+// CalculatePaymentAccountAddress calculates the CREATE2 address of the payment account
+// created with the given nonce. This matches EnterpriseWallet.predictPaymentAccountAddress
+// when nonce is getPaymentAccountNonce().
+func CalculatePaymentAccountAddress(wallet common.Address, nonce *big.Int) common.Address {
+	return calculateEnterpriseAccountAddress(wallet, "payment", nonce, paymentAccountCodeHash)
+}
+
+// CalculateCollectionAccountAddress calculates the CREATE2 address of the collection account
+// created with the given nonce. This matches EnterpriseWallet.predictCollectionAccountAddress
+// when nonce is getCollectionAccountNonce().
+func CalculateCollectionAccountAddress(wallet common.Address, nonce *big.Int) common.Address {
+	return calculateEnterpriseAccountAddress(wallet, "collection", nonce, collectionAccountCodeHash)
+}
+
+// calculateEnterpriseAccountAddress deploys from the wallet itself with
+// salt = keccak256(abi.encodePacked(wallet, kind, nonce)). The accounts have no
+// constructor arguments, so the name and controller do not affect the address.
+func calculateEnterpriseAccountAddress(wallet common.Address, kind string, nonce *big.Int, codeHash []byte) common.Address {
+	nonceBytes := make([]byte, 32)
+	if nonce != nil {
+		nonce.FillBytes(nonceBytes)
+	}
+
+	var salt [32]byte
+	copy(salt[:], crypto.Keccak256(EncodePackedData(wallet.Bytes(), []byte(kind), nonceBytes)))
+
+	return crypto.CreateAddress2(wallet, salt, codeHash)
+}
@@ -184,6 +184,45 @@ func CollectFundsData(token common.Address, collectionAccount common.Address) ([
 	return data, nil
 }
 
+// BatchCollectFundsData creates the call data for collecting one token from several collection accounts
+func BatchCollectFundsData(token common.Address, collectionAccounts []common.Address) ([]byte, error) {
+	if len(collectionAccounts) == 0 {
+		return nil, fmt.Errorf("collectionAccounts cannot be empty")
+	}
+
+	parsedABI, err := abi.JSON(strings.NewReader(EnterpriseWalletABI))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse EnterpriseWallet ABI: %w", err)
+	}
+
+	data, err := parsedABI.Pack("batchCollectFunds", token, collectionAccounts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode batchCollectFunds call: %w", err)
+	}
+
+	return data, nil
+}
+
+// RescueFundsData creates the call data for rescuing ETH (zero token) or tokens held by the
+// wallet contract itself. The contract sends the funds to the caller.
+func RescueFundsData(token common.Address, amount *big.Int) ([]byte, error) {
+	if amount == nil || amount.Sign() <= 0 {
+		return nil, fmt.Errorf("amount must be positive")
+	}
+
+	parsedABI, err := abi.JSON(strings.NewReader(EnterpriseWalletABI))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse EnterpriseWallet ABI: %w", err)
+	}
+
+	data, err := parsedABI.Pack("rescueFunds", token, amount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode rescueFunds call: %w", err)
+	}
+
+	return data, nil
+}
+
 // GetMethodSelector returns the 4-byte method selector for a function signature
 func GetMethodSelector(signature string) [4]byte {
 	hash := crypto.Keccak256([]byte(signature))
@@ -199,6 +238,7 @@ var (
 	ApproveTokenForPaymentSelector         = GetMethodSelector("approveTokenForPayment(address,address,uint256)")
 	TransferETHToPaymentSelector           = GetMethodSelector("transferETHToPayment(address,uint256)")
 	CollectFundsSelector                   = GetMethodSelector("collectFunds(address,address)")
+	RescueFundsSelector                    = GetMethodSelector("rescueFunds(address,uint256)")
 	CreateSafeAndPaymentAccountSelector    = GetMethodSelector("createSafeAndPaymentAccount(address,address,(address[],uint256,address,bytes,address,address,uint256,address,uint256),string)")
 
 	// SuperAdmin transfer selectors
@@ -313,6 +353,40 @@ func EmergencyFreezeData(target common.Address, freeze bool) ([]byte, error) {
 	return data, nil
 }
 
+// BatchEmergencyFreezeData creates the call data for freezing/unfreezing several accounts at once
+func BatchEmergencyFreezeData(targets []common.Address, freeze bool) ([]byte, error) {
+	if len(targets) == 0 {
+		return nil, fmt.Errorf("targets cannot be empty")
+	}
+
+	parsedABI, err := abi.JSON(strings.NewReader(EnterpriseWalletABI))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse EnterpriseWallet ABI: %w", err)
+	}
+
+	data, err := parsedABI.Pack("batchEmergencyFreeze", targets, freeze)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode batchEmergencyFreeze call: %w", err)
+	}
+
+	return data, nil
+}
+
+// EmergencyPauseData creates the call data for pausing/unpausing the whole wallet
+func EmergencyPauseData(pause bool) ([]byte, error) {
+	parsedABI, err := abi.JSON(strings.NewReader(EnterpriseWalletABI))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse EnterpriseWallet ABI: %w", err)
+	}
+
+	data, err := parsedABI.Pack("emergencyPause", pause)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode emergencyPause call: %w", err)
+	}
+
+	return data, nil
+}
+
 // CreateSafeAndPaymentAccountData encodes createSafeAndPaymentAccount call data
 func CreateSafeAndPaymentAccountData(
 	safeProxyFactory common.Address,
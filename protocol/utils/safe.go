@@ -238,3 +238,55 @@ func SafeSetGuardData(guard common.Address) ([]byte, error) {
 
 	return data, nil
 }
+
+// SafeEnableModuleData creates calldata for enableModule function
+// Parameters:
+//   - module: Module address to enable
+func SafeEnableModuleData(module common.Address) ([]byte, error) {
+	abi, err := SafeContractMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Safe ABI: %w", err)
+	}
+
+	data, err := abi.Pack("enableModule", module)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode enableModule call: %w", err)
+	}
+
+	return data, nil
+}
+
+// SafeDisableModuleData creates calldata for disableModule function
+// Parameters:
+//   - prevModule: Previous module in the linked list (use sentinel 0x1 if disabling first module)
+//   - module: Module address to disable
+func SafeDisableModuleData(prevModule, module common.Address) ([]byte, error) {
+	abi, err := SafeContractMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Safe ABI: %w", err)
+	}
+
+	data, err := abi.Pack("disableModule", prevModule, module)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode disableModule call: %w", err)
+	}
+
+	return data, nil
+}
+
+// SafeSetFallbackHandlerData creates calldata for setFallbackHandler function
+// Parameters:
+//   - handler: Fallback handler address (use zero address to remove the handler)
+func SafeSetFallbackHandlerData(handler common.Address) ([]byte, error) {
+	abi, err := SafeContractMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Safe ABI: %w", err)
+	}
+
+	data, err := abi.Pack("setFallbackHandler", handler)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode setFallbackHandler call: %w", err)
+	}
+
+	return data, nil
+}
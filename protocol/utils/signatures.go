@@ -224,23 +224,23 @@ func encodeSafeTransactionData(
 	)
 }
 
-// CalculateMessageHash calculates the hash of a Safe message for signing
+// CalculateMessageHash calculates the hash of a Safe message for signing, as returned
+// by CompatibilityFallbackHandler.getMessageHash. message is the EIP-191 or EIP-712
+// hash of the original message.
 func CalculateMessageHash(
 	safeAddress common.Address,
 	message []byte,
 	chainID *big.Int,
 ) ([]byte, error) {
-	// This is a placeholder implementation
-	// In a real implementation, this would:
-	// 1. Encode the message according to Safe's format
-	// 2. Calculate the hash using the Safe's domain separator
-	// 3. Return the final hash for signing
+	if len(message) == 0 {
+		return nil, fmt.Errorf("message cannot be empty")
+	}
 
-	// Placeholder hash calculation
-	data := append(safeAddress.Bytes(), message...)
-	data = append(data, chainID.Bytes()...)
+	safeMessageTypeHash := crypto.Keccak256([]byte("SafeMessage(bytes message)"))
+	structHash := crypto.Keccak256(safeMessageTypeHash, crypto.Keccak256(message))
 
-	hash := crypto.Keccak256(data)
+	domainSeparator := calculateDomainSeparator(safeAddress, chainID)
+	hash := crypto.Keccak256([]byte{0x19, 0x01}, domainSeparator, structHash)
 	return hash, nil
 }
 
@@ -256,4 +256,79 @@ func AdjustVInSignature(signature []byte) []byte {
 	}
 
 	return signature
-}
\ No newline at end of file
+}
+
+// Signature types as reported by the Safe Transaction Service
+const (
+	SignatureTypeEOA          = "EOA"
+	SignatureTypeEthSign      = "ETH_SIGN"
+	SignatureTypeApprovedHash = "APPROVED_HASH"
+	SignatureTypeContract     = "CONTRACT_SIGNATURE"
+)
+
+// DecodedSafeSignature represents one signature recovered from packed Safe signature bytes
+type DecodedSafeSignature struct {
+	Signer    common.Address // Owner the signature belongs to
+	Type      string         // One of the SignatureType constants
+	Signature []byte         // Static part, followed by the dynamic part for contract signatures
+}
+
+// DecodeSafeSignatures splits packed Safe signatures and recovers each signer.
+// The static area ends where the first contract signature's dynamic data starts.
+// Like the Safe, only the first threshold signatures are decoded; bytes after them
+// are ignored. A threshold of 0 decodes every signature.
+func DecodeSafeSignatures(dataHash []byte, signatures []byte, threshold int) ([]DecodedSafeSignature, error) {
+	staticEnd := len(signatures)
+	var decoded []DecodedSafeSignature
+
+	for offset := 0; offset+65 <= staticEnd && (threshold <= 0 || len(decoded) < threshold); offset += 65 {
+		static := signatures[offset : offset+65]
+		r, s, v := static[:32], static[32:64], static[64]
+
+		sig := DecodedSafeSignature{Signature: append([]byte(nil), static...)}
+
+		switch {
+		case v == 0:
+			sig.Type = SignatureTypeContract
+			sig.Signer = common.BytesToAddress(r)
+
+			dynamicOffset := new(big.Int).SetBytes(s)
+			if dynamicOffset.Cmp(big.NewInt(int64(len(signatures)-32))) > 0 {
+				return nil, fmt.Errorf("contract signature %d has invalid offset", len(decoded))
+			}
+			start := int(dynamicOffset.Int64())
+			length := new(big.Int).SetBytes(signatures[start : start+32])
+			if length.Cmp(big.NewInt(int64(len(signatures)-start-32))) > 0 {
+				return nil, fmt.Errorf("contract signature %d has invalid length", len(decoded))
+			}
+			sig.Signature = append(sig.Signature, signatures[start:start+32+int(length.Int64())]...)
+			if start < staticEnd {
+				staticEnd = start
+			}
+		case v == 1:
+			sig.Type = SignatureTypeApprovedHash
+			sig.Signer = common.BytesToAddress(r)
+		case v > 30:
+			sig.Type = SignatureTypeEthSign
+			prefixed := crypto.Keccak256([]byte("\x19Ethereum Signed Message:\n32"), dataHash)
+			adjusted := append([]byte(nil), static...)
+			adjusted[64] -= 4
+			signer, err := RecoverSigner(prefixed, adjusted)
+			if err != nil {
+				return nil, fmt.Errorf("failed to recover eth_sign signer: %w", err)
+			}
+			sig.Signer = signer
+		default:
+			sig.Type = SignatureTypeEOA
+			signer, err := RecoverSigner(dataHash, static)
+			if err != nil {
+				return nil, fmt.Errorf("failed to recover signer: %w", err)
+			}
+			sig.Signer = signer
+		}
+
+		decoded = append(decoded, sig)
+	}
+
+	return decoded, nil
+}
@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"math/big"
 	"strconv"
+	"strings"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/vikkkko/safe-core-sdk-golang/types"
 )
@@ -104,6 +106,29 @@ func EncodeMultiSendData(transactions []types.MetaTransactionData) ([]byte, erro
 	return encoded, nil
 }
 
+// MultiSendABI contains the ABI of the MultiSend and MultiSendCallOnly entry point
+const MultiSendABI = `[{"inputs":[{"internalType":"bytes","name":"transactions","type":"bytes"}],"name":"multiSend","outputs":[],"stateMutability":"payable","type":"function"}]`
+
+// CreateMultiSendData creates the call data for multiSend wrapping the packed transactions
+func CreateMultiSendData(transactions []types.MetaTransactionData) ([]byte, error) {
+	encoded, err := EncodeMultiSendData(transactions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode MultiSend transactions: %w", err)
+	}
+
+	parsedABI, err := abi.JSON(strings.NewReader(MultiSendABI))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse MultiSend ABI: %w", err)
+	}
+
+	data, err := parsedABI.Pack("multiSend", encoded)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode multiSend call: %w", err)
+	}
+
+	return data, nil
+}
+
 // EstimateTxGas estimates gas for a transaction
 func EstimateTxGas(txData types.SafeTransactionData) (*big.Int, error) {
 	// This is a placeholder implementation
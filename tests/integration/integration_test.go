@@ -7,8 +7,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/vikkkko/safe-core-sdk-golang/api"
 	"github.com/vikkkko/safe-core-sdk-golang/protocol"
+	"github.com/vikkkko/safe-core-sdk-golang/protocol/enterprise"
 	"github.com/vikkkko/safe-core-sdk-golang/types"
 )
 
@@ -292,6 +295,64 @@ func TestSafeAddressPrediction(t *testing.T) {
 	})
 }
 
+func TestEnterpriseAccountPredictionIntegration(t *testing.T) {
+	if os.Getenv("RUN_INTEGRATION_TESTS") != "true" {
+		t.Skip("Skipping integration test - set RUN_INTEGRATION_TESTS=true to run")
+	}
+
+	rpcURL := os.Getenv("RPC_URL")
+	if rpcURL == "" {
+		t.Skip("Skipping integration test - RPC_URL not set")
+	}
+
+	walletAddress := os.Getenv("ENTERPRISE_WALLET_ADDRESS")
+	if walletAddress == "" {
+		t.Skip("Skipping integration test - ENTERPRISE_WALLET_ADDRESS not set")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	client, err := ethclient.DialContext(ctx, rpcURL)
+	if err != nil {
+		t.Fatalf("Failed to connect to RPC: %v", err)
+	}
+	defer client.Close()
+
+	wallet, err := enterprise.NewEnterpriseWalletClient(common.HexToAddress(walletAddress), client)
+	if err != nil {
+		t.Fatalf("Failed to create enterprise wallet client: %v", err)
+	}
+
+	t.Run("PaymentAccount", func(t *testing.T) {
+		onChain, err := wallet.PredictPaymentAccountAddress(ctx)
+		if err != nil {
+			t.Fatalf("Failed to predict on-chain: %v", err)
+		}
+		offline, err := wallet.NextPaymentAccountAddresses(ctx, 1)
+		if err != nil {
+			t.Fatalf("Failed to predict offline: %v", err)
+		}
+		if offline[0] != onChain {
+			t.Errorf("Expected payment account %s, got %s", onChain.Hex(), offline[0].Hex())
+		}
+	})
+
+	t.Run("CollectionAccount", func(t *testing.T) {
+		onChain, err := wallet.PredictCollectionAccountAddress(ctx)
+		if err != nil {
+			t.Fatalf("Failed to predict on-chain: %v", err)
+		}
+		offline, err := wallet.NextCollectionAccountAddresses(ctx, 1)
+		if err != nil {
+			t.Fatalf("Failed to predict offline: %v", err)
+		}
+		if offline[0] != onChain {
+			t.Errorf("Expected collection account %s, got %s", onChain.Hex(), offline[0].Hex())
+		}
+	})
+}
+
 // Helper functions
 func intPtr(i int) *int {
 	return &i
@@ -0,0 +1,197 @@
+package unit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/vikkkko/safe-core-sdk-golang/api"
+)
+
+const (
+	testSafe   = "0x1111111111111111111111111111111111111111"
+	testModule = "0x2222222222222222222222222222222222222222"
+	testToken  = "0x3333333333333333333333333333333333333333"
+)
+
+// newTransactionService starts a fake Transaction Service. queuedNonce is the nonce
+// of the highest queued transaction, or -1 when nothing is queued.
+func newTransactionService(queuedNonce int64, queries map[string]url.Values) *httptest.Server {
+	mux := http.NewServeMux()
+	record := func(r *http.Request) {
+		queries[r.URL.Path] = r.URL.Query()
+	}
+
+	mux.HandleFunc("GET /api/v1/about/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"name":"Safe Transaction Service","version":"5.0.0"}`)
+	})
+	mux.HandleFunc("GET /api/v1/safes/{address}/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"address":%q,"nonce":"7","threshold":1}`, r.PathValue("address"))
+	})
+	mux.HandleFunc("GET /api/v1/safes/{address}/creation/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"created":"2024-01-02T03:04:05Z","creator":"0xcreator","transactionHash":"0xhash","factoryAddress":"0xfactory","masterCopy":"0xsingleton","setupData":"0x"}`)
+	})
+	mux.HandleFunc("GET /api/v1/modules/{address}/safes/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"safes":[%q]}`, testSafe)
+	})
+	mux.HandleFunc("GET /api/v1/tokens/", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		fmt.Fprintf(w, `{"count":1,"next":null,"previous":null,"results":[{"type":"ERC20","address":%q,"name":"Tether","symbol":"USDT","decimals":6}]}`, testToken)
+	})
+	mux.HandleFunc("GET /api/v1/tokens/{address}/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"type":"ERC20","address":%q,"name":"Tether","symbol":"USDT","decimals":6}`, r.PathValue("address"))
+	})
+	mux.HandleFunc("GET /api/v1/safes/{address}/all-transactions/", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		fmt.Fprint(w, `{"count":4,"next":null,"previous":null,"results":[
+			{"txType":"MULTISIG_TRANSACTION","safeTxHash":"0xsafetx","nonce":6,"isExecuted":true,"submissionDate":"2024-01-01T00:00:00Z","modified":"2024-01-01T00:00:00Z"},
+			{"txType":"MODULE_TRANSACTION","module":"0xmodule","transactionHash":"0xmoduletx","created":"2024-01-01T00:00:00Z","executionDate":"2024-01-01T00:00:00Z"},
+			{"txType":"ETHEREUM_TRANSACTION","txHash":"0xethtx","from":"0xsender","executionDate":"2024-01-01T00:00:00Z","transfers":[]},
+			{"txType":"SETTINGS_CHANGE","id":"0xunknown"}]}`)
+	})
+	mux.HandleFunc("GET /api/v1/safes/{address}/module-transactions/", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		fmt.Fprintf(w, `{"count":1,"next":null,"previous":null,"results":[{"module":%q,"transactionHash":"0xmoduletx","isSuccessful":true,"created":"2024-01-01T00:00:00Z","executionDate":"2024-01-01T00:00:00Z"}]}`, testModule)
+	})
+	mux.HandleFunc("GET /api/v1/safes/{address}/multisig-transactions/", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if queuedNonce < 0 {
+			fmt.Fprint(w, `{"count":0,"next":null,"previous":null,"results":[]}`)
+			return
+		}
+		fmt.Fprintf(w, `{"count":1,"next":null,"previous":null,"results":[{"nonce":%d,"submissionDate":"2024-01-01T00:00:00Z","modified":"2024-01-01T00:00:00Z"}]}`, queuedNonce)
+	})
+
+	return httptest.NewServer(mux)
+}
+
+func TestSafeApiKitEndpoints(t *testing.T) {
+	queries := make(map[string]url.Values)
+	server := newTransactionService(-1, queries)
+	defer server.Close()
+
+	kit, err := api.NewSafeApiKit(api.SafeApiKitConfig{ChainID: 1, TxServiceURL: server.URL})
+	if err != nil {
+		t.Fatalf("Failed to create API kit: %v", err)
+	}
+	ctx := context.Background()
+
+	info, err := kit.GetServiceInfo(ctx)
+	if err != nil || info.Version != "5.0.0" {
+		t.Errorf("Unexpected service info %+v: %v", info, err)
+	}
+
+	creation, err := kit.GetSafeCreationInfo(ctx, testSafe)
+	if err != nil || creation.Creator != "0xcreator" || creation.Created.Year() != 2024 {
+		t.Errorf("Unexpected creation info %+v: %v", creation, err)
+	}
+
+	safes, err := kit.GetSafesByModule(ctx, testModule)
+	if err != nil || len(safes.Safes) != 1 || safes.Safes[0] != testSafe {
+		t.Errorf("Unexpected Safes by module %+v: %v", safes, err)
+	}
+
+	limit, offset := 10, 20
+	tokens, err := kit.GetTokenList(ctx, &api.TokenInfoListOptions{Limit: &limit, Offset: &offset})
+	if err != nil || len(tokens.Results) != 1 || tokens.Results[0].Symbol != "USDT" {
+		t.Errorf("Unexpected token list %+v: %v", tokens, err)
+	}
+	if q := queries["/api/v1/tokens/"]; q.Get("limit") != "10" || q.Get("offset") != "20" {
+		t.Errorf("Unexpected token list query: %v", q)
+	}
+
+	token, err := kit.GetToken(ctx, testToken)
+	if err != nil || token.Address != testToken || token.Decimals != 6 {
+		t.Errorf("Unexpected token %+v: %v", token, err)
+	}
+
+	executed := true
+	all, err := kit.GetAllTransactions(ctx, testSafe, &api.AllTransactionsOptions{Executed: &executed, Limit: &limit})
+	if err != nil {
+		t.Fatalf("Failed to get all transactions: %v", err)
+	}
+	if len(all.Results) != 4 ||
+		all.Results[0].Type != "MULTISIG_TRANSACTION" || all.Results[0].MultisigTransaction.SafeTxHash != "0xsafetx" ||
+		all.Results[1].ModuleTransaction == nil || all.Results[1].ModuleTransaction.Module != "0xmodule" ||
+		all.Results[2].EthereumTransaction == nil || all.Results[2].EthereumTransaction.TxHash != "0xethtx" {
+		t.Errorf("Unexpected all transactions: %+v", all.Results)
+	}
+	// Entries of an unknown type keep the raw entry
+	if unknown := all.Results[3]; unknown.Type != "SETTINGS_CHANGE" || string(unknown.Raw) != `{"txType":"SETTINGS_CHANGE","id":"0xunknown"}` {
+		t.Errorf("Unexpected unknown transaction %+v", unknown)
+	}
+
+	// Results encode back to the entries returned by the service
+	encoded, err := json.Marshal(all.Results)
+	if err != nil {
+		t.Fatalf("Failed to encode all transactions: %v", err)
+	}
+	var roundTrip []api.AllTransactionResult
+	if err := json.Unmarshal(encoded, &roundTrip); err != nil {
+		t.Fatalf("Failed to decode all transactions: %v", err)
+	}
+	if len(roundTrip) != 4 || roundTrip[0].MultisigTransaction == nil || roundTrip[0].MultisigTransaction.SafeTxHash != "0xsafetx" ||
+		roundTrip[3].Type != "SETTINGS_CHANGE" || string(roundTrip[3].Raw) != string(all.Results[3].Raw) {
+		t.Errorf("Unexpected round trip %s", encoded)
+	}
+	built, err := json.Marshal(api.AllTransactionResult{Type: "MODULE_TRANSACTION", ModuleTransaction: &api.SafeModuleTransactionResponse{Module: "0xmodule"}})
+	if err != nil {
+		t.Fatalf("Failed to encode module transaction: %v", err)
+	}
+	var decoded api.AllTransactionResult
+	if err := json.Unmarshal(built, &decoded); err != nil || decoded.ModuleTransaction == nil || decoded.ModuleTransaction.Module != "0xmodule" {
+		t.Errorf("Unexpected module transaction %s: %v", built, err)
+	}
+	if q := queries["/api/v1/safes/"+testSafe+"/all-transactions/"]; q.Get("executed") != "true" || q.Get("limit") != "10" || q.Has("queued") {
+		t.Errorf("Unexpected all transactions query: %v", q)
+	}
+
+	moduleTxs, err := kit.GetModuleTransactions(ctx, testSafe, &api.GetModuleTransactionsOptions{Module: testModule})
+	if err != nil || len(moduleTxs.Results) != 1 || !moduleTxs.Results[0].IsSuccessful {
+		t.Errorf("Unexpected module transactions %+v: %v", moduleTxs, err)
+	}
+	if q := queries["/api/v1/safes/"+testSafe+"/module-transactions/"]; q.Get("module") != testModule {
+		t.Errorf("Unexpected module transactions query: %v", q)
+	}
+}
+
+func TestGetNextNonce(t *testing.T) {
+	tests := []struct {
+		name        string
+		queuedNonce int64
+		expected    int64
+	}{
+		{name: "NothingQueued", queuedNonce: -1, expected: 7},
+		{name: "Queued", queuedNonce: 9, expected: 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queries := make(map[string]url.Values)
+			server := newTransactionService(tt.queuedNonce, queries)
+			defer server.Close()
+
+			kit, err := api.NewSafeApiKit(api.SafeApiKitConfig{ChainID: 1, TxServiceURL: server.URL})
+			if err != nil {
+				t.Fatalf("Failed to create API kit: %v", err)
+			}
+
+			nonce, err := kit.GetNextNonce(context.Background(), testSafe)
+			if err != nil {
+				t.Fatalf("Failed to get next nonce: %v", err)
+			}
+			if nonce != tt.expected {
+				t.Errorf("Expected nonce %d, got %d", tt.expected, nonce)
+			}
+
+			q := queries["/api/v1/safes/"+testSafe+"/multisig-transactions/"]
+			if q.Get("executed") != "false" || q.Get("nonce__gte") != "7" || q.Get("ordering") != "-nonce" {
+				t.Errorf("Unexpected queued transactions query: %v", q)
+			}
+		})
+	}
+}
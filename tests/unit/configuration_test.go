@@ -0,0 +1,113 @@
+package unit
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/vikkkko/safe-core-sdk-golang/protocol"
+	"github.com/vikkkko/safe-core-sdk-golang/protocol/utils"
+)
+
+func TestPlanConfigurationChanges(t *testing.T) {
+	safeAddress := common.HexToAddress("0x9999999999999999999999999999999999999999")
+	sentinel := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	ownerA := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	ownerB := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	ownerC := common.HexToAddress("0x3333333333333333333333333333333333333333")
+	ownerD := common.HexToAddress("0x4444444444444444444444444444444444444444")
+	moduleA := common.HexToAddress("0x5555555555555555555555555555555555555555")
+	moduleB := common.HexToAddress("0x6666666666666666666666666666666666666666")
+
+	current := protocol.SafeConfigurationState{
+		Owners:    []common.Address{ownerA, ownerB, ownerC},
+		Threshold: 2,
+		Modules:   []common.Address{moduleA},
+	}
+
+	t.Run("NoChanges", func(t *testing.T) {
+		changes, err := protocol.PlanConfigurationChanges(safeAddress, current, protocol.DesiredSafeConfiguration{
+			Owners:    []common.Address{ownerC, ownerB, ownerA},
+			Threshold: 2,
+			Modules:   []common.Address{moduleA},
+		})
+		if err != nil {
+			t.Fatalf("Expected no error but got: %v", err)
+		}
+		if len(changes) != 0 {
+			t.Errorf("Expected no changes, got %d", len(changes))
+		}
+	})
+
+	t.Run("SwapAndRemoveOwner", func(t *testing.T) {
+		changes, err := protocol.PlanConfigurationChanges(safeAddress, current, protocol.DesiredSafeConfiguration{
+			Owners: []common.Address{ownerA, ownerD},
+		})
+		if err != nil {
+			t.Fatalf("Expected no error but got: %v", err)
+		}
+		if len(changes) != 2 {
+			t.Fatalf("Expected 2 changes, got %d", len(changes))
+		}
+
+		swapData, _ := utils.SafeSwapOwnerData(ownerA, ownerB, ownerD)
+		if changes[0].Type != protocol.ConfigurationSwapOwner || changes[0].Transaction.Data != hexutil.Encode(swapData) {
+			t.Errorf("Unexpected first change: %+v", changes[0])
+		}
+
+		// After the swap the list is A -> D -> C, so C's prev pointer is D
+		removeData, _ := utils.SafeRemoveOwnerData(ownerD, ownerC, big.NewInt(2))
+		if changes[1].Type != protocol.ConfigurationRemoveOwner || changes[1].Transaction.Data != hexutil.Encode(removeData) {
+			t.Errorf("Unexpected second change: %+v", changes[1])
+		}
+	})
+
+	t.Run("AddOwnersAndModules", func(t *testing.T) {
+		changes, err := protocol.PlanConfigurationChanges(safeAddress, current, protocol.DesiredSafeConfiguration{
+			Owners:    []common.Address{ownerA, ownerB, ownerC, ownerD},
+			Threshold: 3,
+			Modules:   []common.Address{moduleB},
+		})
+		if err != nil {
+			t.Fatalf("Expected no error but got: %v", err)
+		}
+
+		addData, _ := utils.SafeAddOwnerWithThresholdData(ownerD, big.NewInt(3))
+		disableData, _ := utils.SafeDisableModuleData(sentinel, moduleA)
+		enableData, _ := utils.SafeEnableModuleData(moduleB)
+		expected := []string{hexutil.Encode(addData), hexutil.Encode(disableData), hexutil.Encode(enableData)}
+
+		if len(changes) != len(expected) {
+			t.Fatalf("Expected %d changes, got %d", len(expected), len(changes))
+		}
+		for i, change := range changes {
+			if change.Transaction.Data != expected[i] {
+				t.Errorf("Change %d (%s) has unexpected data", i, change.Type)
+			}
+			if change.Transaction.To != safeAddress.Hex() {
+				t.Errorf("Change %d targets %s, want %s", i, change.Transaction.To, safeAddress.Hex())
+			}
+		}
+	})
+
+	t.Run("ThresholdOnly", func(t *testing.T) {
+		changes, err := protocol.PlanConfigurationChanges(safeAddress, current, protocol.DesiredSafeConfiguration{Threshold: 3})
+		if err != nil {
+			t.Fatalf("Expected no error but got: %v", err)
+		}
+		if len(changes) != 1 || changes[0].Type != protocol.ConfigurationChangeThreshold {
+			t.Errorf("Expected a single changeThreshold, got %+v", changes)
+		}
+	})
+
+	t.Run("InvalidThreshold", func(t *testing.T) {
+		_, err := protocol.PlanConfigurationChanges(safeAddress, current, protocol.DesiredSafeConfiguration{
+			Owners:    []common.Address{ownerA},
+			Threshold: 2,
+		})
+		if err == nil {
+			t.Error("Expected error but got nil")
+		}
+	})
+}
@@ -0,0 +1,194 @@
+package unit
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/vikkkko/safe-core-sdk-golang/protocol/contracts"
+	"github.com/vikkkko/safe-core-sdk-golang/protocol/managers"
+)
+
+var testDelay = common.HexToAddress("0x7777777777777777777777777777777777777777")
+
+// newDelayRPC serves a Delay modifier with the given queue pointers
+func newDelayRPC(t *testing.T, txNonce, queueNonce int64) (*fakeRPC, *managers.DelayManager, *abi.ABI) {
+	delayABI, err := contracts.DelayModifierMetaData.GetAbi()
+	if err != nil {
+		t.Fatalf("Failed to get Delay ABI: %v", err)
+	}
+
+	f := newFakeRPC(t)
+	f.handle(testDelay, delayABI, "txNonce", func([]interface{}) ([]interface{}, error) {
+		return []interface{}{big.NewInt(txNonce)}, nil
+	})
+	f.handle(testDelay, delayABI, "queueNonce", func([]interface{}) ([]interface{}, error) {
+		return []interface{}{big.NewInt(queueNonce)}, nil
+	})
+
+	client := f.client(t)
+	safeAddress := common.HexToAddress(testSafe)
+	manager := managers.NewDelayManager(client, managers.NewModuleManager(client, safeAddress), testDelay)
+	return f, manager, delayABI
+}
+
+func TestDelayQueueStatus(t *testing.T) {
+	f, manager, delayABI := newDelayRPC(t, 0, 4)
+
+	// The latest block is at now, cooldown 100s and expiration 200s
+	const now = 1_700_000_000
+	f.time = now - f.head.Uint64()
+	f.handle(testDelay, delayABI, "txCooldown", func([]interface{}) ([]interface{}, error) {
+		return []interface{}{big.NewInt(100)}, nil
+	})
+	f.handle(testDelay, delayABI, "txExpiration", func([]interface{}) ([]interface{}, error) {
+		return []interface{}{big.NewInt(200)}, nil
+	})
+
+	createdAt := []int64{
+		now - 100, // cooldown ends exactly now
+		now - 99,  // cooldown ends in one second
+		now - 300, // last second before expiry
+		now - 301, // expired one second ago
+	}
+	f.handle(testDelay, delayABI, "getTxCreatedAt", func(args []interface{}) ([]interface{}, error) {
+		return []interface{}{big.NewInt(createdAt[args[0].(*big.Int).Int64()])}, nil
+	})
+
+	event := delayABI.Events["TransactionAdded"]
+	for nonce := range createdAt {
+		data, err := event.Inputs.NonIndexed().Pack(common.HexToAddress(testSafe), big.NewInt(0), []byte{}, uint8(0))
+		if err != nil {
+			t.Fatalf("Failed to pack event data: %v", err)
+		}
+		f.addLog(gethtypes.Log{
+			Address:     testDelay,
+			Topics:      []common.Hash{event.ID, common.BigToHash(big.NewInt(int64(nonce))), crypto.Keccak256Hash([]byte{byte(nonce)})},
+			Data:        data,
+			BlockNumber: 10,
+			TxHash:      common.HexToHash("0x01"),
+		})
+	}
+
+	queue, err := manager.GetQueue(context.Background(), 0)
+	if err != nil {
+		t.Fatalf("Failed to get queue: %v", err)
+	}
+
+	expected := []managers.DelayTxStatus{managers.DelayTxReady, managers.DelayTxCooldown, managers.DelayTxReady, managers.DelayTxExpired}
+	if len(queue) != len(expected) {
+		t.Fatalf("Expected %d queued transactions, got %d", len(expected), len(queue))
+	}
+	for i, item := range queue {
+		if item.Status != expected[i] {
+			t.Errorf("Transaction %d: expected %s, got %s", i, expected[i], item.Status)
+		}
+		if item.ExpiresAt == nil || item.ExpiresAt.Sub(item.ReadyAt).Seconds() != 200 {
+			t.Errorf("Transaction %d: expected expiry 200s after the cooldown, got %v", i, item.ExpiresAt)
+		}
+	}
+}
+
+func TestDelayVetoBounds(t *testing.T) {
+	_, manager, delayABI := newDelayRPC(t, 2, 5)
+	ctx := context.Background()
+
+	setTxNonce := func(nonce int64) []byte {
+		data, err := delayABI.Pack("setTxNonce", big.NewInt(nonce))
+		if err != nil {
+			t.Fatalf("Failed to pack setTxNonce: %v", err)
+		}
+		return data
+	}
+
+	// Only nonces in [txNonce, queueNonce) can be vetoed
+	for _, nonce := range []int64{1, 5} {
+		if _, err := manager.CreateVetoTx(ctx, big.NewInt(nonce)); err == nil {
+			t.Errorf("Expected veto of nonce %d to fail", nonce)
+		}
+	}
+	for _, nonce := range []int64{2, 4} {
+		data, err := manager.CreateVetoTx(ctx, big.NewInt(nonce))
+		if err != nil {
+			t.Fatalf("Failed to veto nonce %d: %v", nonce, err)
+		}
+		if !bytes.Equal(data, setTxNonce(nonce+1)) {
+			t.Errorf("Veto of nonce %d should set the tx nonce to %d", nonce, nonce+1)
+		}
+	}
+
+	data, err := manager.CreateSkipNextTx(ctx)
+	if err != nil || !bytes.Equal(data, setTxNonce(3)) {
+		t.Errorf("Skip next should set the tx nonce to 3: %v", err)
+	}
+	data, err = manager.CreateVetoAllTx(ctx)
+	if err != nil || !bytes.Equal(data, setTxNonce(5)) {
+		t.Errorf("Veto all should set the tx nonce to 5: %v", err)
+	}
+
+	// Nothing to veto on an empty queue
+	_, emptyManager, _ := newDelayRPC(t, 0, 0)
+	if _, err := emptyManager.CreateVetoAllTx(ctx); err == nil {
+		t.Error("Expected veto all to fail on an empty queue")
+	}
+	if _, err := emptyManager.CreateSkipNextTx(ctx); err == nil {
+		t.Error("Expected skip next to fail on an empty queue")
+	}
+}
+
+func TestCreateDeployDelayTx(t *testing.T) {
+	safeAddress := common.HexToAddress(testSafe)
+	masterCopy := common.HexToAddress("0xd54895B1121A2eE3f37b502F507631FA1331BED6")
+
+	_, manager, _ := newDelayRPC(t, 0, 0)
+	deployment, err := manager.CreateDeployDelayTx(managers.DeployDelayParams{
+		MasterCopy: masterCopy,
+		Cooldown:   big.NewInt(86400),
+		Expiration: big.NewInt(604800),
+		SaltNonce:  big.NewInt(42),
+	})
+	if err != nil {
+		t.Fatalf("Failed to prepare deployment: %v", err)
+	}
+
+	if deployment.Factory != managers.DefaultModuleProxyFactoryAddress {
+		t.Errorf("Expected the default factory, got %s", deployment.Factory.Hex())
+	}
+
+	// The calldata deploys the mastercopy with a setUp initializer for the Safe
+	factoryABI, err := contracts.ModuleProxyFactoryMetaData.GetAbi()
+	if err != nil {
+		t.Fatalf("Failed to get factory ABI: %v", err)
+	}
+	args, err := factoryABI.Methods["deployModule"].Inputs.Unpack(deployment.Data[4:])
+	if err != nil {
+		t.Fatalf("Failed to unpack deployModule: %v", err)
+	}
+	initializer, err := managers.DelaySetUpData(safeAddress, safeAddress, safeAddress, big.NewInt(86400), big.NewInt(604800))
+	if err != nil {
+		t.Fatalf("Failed to encode setUp: %v", err)
+	}
+	if args[0].(common.Address) != masterCopy || !bytes.Equal(args[1].([]byte), initializer) || args[2].(*big.Int).Int64() != 42 {
+		t.Errorf("Unexpected deployModule arguments %v", args)
+	}
+
+	// ModuleProxyFactory.deployModule deploys an EIP-1167 proxy with CREATE2 and
+	// salt keccak256(abi.encodePacked(keccak256(initializer), saltNonce))
+	initCode := common.FromHex("0x602d8060093d393df3363d3d373d3d3d363d73d54895b1121a2ee3f37b502f507631fa1331bed65af43d82803e903d91602b57fd5bf3")
+	salt := crypto.Keccak256(crypto.Keccak256(initializer), common.LeftPadBytes([]byte{42}, 32))
+	create2 := crypto.Keccak256([]byte{0xff}, managers.DefaultModuleProxyFactoryAddress.Bytes(), salt, crypto.Keccak256(initCode))
+	if derived := common.BytesToAddress(create2[12:]); deployment.PredictedAddress != derived {
+		t.Errorf("Expected predicted address %s, got %s", derived.Hex(), deployment.PredictedAddress.Hex())
+	}
+
+	// Pinned so that changes to the setUp encoding are caught
+	expected := common.HexToAddress("0xe057CF69F41E44d0bA6288aD2335Ba096a264d2b")
+	if deployment.PredictedAddress != expected {
+		t.Errorf("Expected predicted address %s, got %s", expected.Hex(), deployment.PredictedAddress.Hex())
+	}
+}
@@ -0,0 +1,572 @@
+package unit
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/vikkkko/safe-core-sdk-golang/protocol"
+	"github.com/vikkkko/safe-core-sdk-golang/protocol/contracts"
+	"github.com/vikkkko/safe-core-sdk-golang/protocol/enterprise"
+	"github.com/vikkkko/safe-core-sdk-golang/protocol/utils"
+	"github.com/vikkkko/safe-core-sdk-golang/types"
+)
+
+// recordingSender captures calls instead of sending them
+type recordingSender struct {
+	calls []enterprise.Call
+}
+
+func (s *recordingSender) From() common.Address {
+	return common.HexToAddress("0x1111111111111111111111111111111111111111")
+}
+
+func (s *recordingSender) Send(ctx context.Context, call enterprise.Call) (*enterprise.CallResult, error) {
+	s.calls = append(s.calls, call)
+	return &enterprise.CallResult{}, nil
+}
+
+func TestEnterpriseWalletClientCalldata(t *testing.T) {
+	walletAddress := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	controller := common.HexToAddress("0x3333333333333333333333333333333333333333")
+
+	client, err := enterprise.NewEnterpriseWalletClient(walletAddress, nil)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	sender := &recordingSender{}
+	if _, err := client.CreatePaymentAccount(context.Background(), sender, "payroll", controller); err != nil {
+		t.Fatalf("Failed to create payment account: %v", err)
+	}
+	if _, err := client.EmergencyFreeze(context.Background(), sender, controller, true); err != nil {
+		t.Fatalf("Failed to freeze account: %v", err)
+	}
+
+	expectedCreate, err := utils.CreatePaymentAccountData("payroll", controller)
+	if err != nil {
+		t.Fatalf("Failed to encode expected calldata: %v", err)
+	}
+	expectedFreeze, err := utils.EmergencyFreezeData(controller, true)
+	if err != nil {
+		t.Fatalf("Failed to encode expected calldata: %v", err)
+	}
+
+	if len(sender.calls) != 2 {
+		t.Fatalf("Expected 2 calls, got %d", len(sender.calls))
+	}
+	for i, expected := range [][]byte{expectedCreate, expectedFreeze} {
+		call := sender.calls[i]
+		if call.To != walletAddress {
+			t.Errorf("Call %d: expected target %s, got %s", i, walletAddress.Hex(), call.To.Hex())
+		}
+		if !bytes.Equal(call.Data, expected) {
+			t.Errorf("Call %d: calldata mismatch", i)
+		}
+	}
+
+	if _, err := client.UpdateMethodControllers(context.Background(), sender, [][4]byte{{0x01}}, nil); err == nil {
+		t.Error("Expected error for mismatched method and controller counts")
+	}
+}
+
+func TestPaymentAccountClientCalldata(t *testing.T) {
+	accountAddress := common.HexToAddress("0x4444444444444444444444444444444444444444")
+	recipient := common.HexToAddress("0x5555555555555555555555555555555555555555")
+
+	client, err := enterprise.NewPaymentAccountClient(accountAddress, nil)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	sender := &recordingSender{}
+	if _, err := client.Transfer(context.Background(), sender, common.Address{}, accountAddress, recipient, big.NewInt(1000)); err != nil {
+		t.Fatalf("Failed to transfer: %v", err)
+	}
+
+	expected, err := utils.PaymentAccountTransferData(common.Address{}, accountAddress, recipient, big.NewInt(1000))
+	if err != nil {
+		t.Fatalf("Failed to encode expected calldata: %v", err)
+	}
+	if len(sender.calls) != 1 || sender.calls[0].To != accountAddress || !bytes.Equal(sender.calls[0].Data, expected) {
+		t.Errorf("Unexpected transfer call: %+v", sender.calls)
+	}
+
+	if _, err := client.Approve(context.Background(), sender, common.Address{}, recipient, big.NewInt(1)); err == nil {
+		t.Error("Expected error when approving ETH")
+	}
+	if _, err := client.Transfer(context.Background(), sender, common.Address{}, accountAddress, recipient, big.NewInt(0)); err == nil {
+		t.Error("Expected error for zero transfer amount")
+	}
+}
+
+func TestResolveMethodControllerPolicy(t *testing.T) {
+	walletABI, err := contracts.EnterpriseWalletMetaData.GetAbi()
+	if err != nil {
+		t.Fatalf("Failed to get ABI: %v", err)
+	}
+
+	safeController := common.HexToAddress("0x6666666666666666666666666666666666666666")
+	resolved, err := enterprise.ResolveMethodControllerPolicy(walletABI, enterprise.MethodControllerPolicy{
+		"createPaymentAccount":                 safeController,
+		"collectFunds(address,address)":        safeController,
+		"0xe8f6940e":                           safeController,
+		"createCollectionAccount":              safeController,
+		"createPaymentAccount(string,address)": safeController,
+	})
+	if err != nil {
+		t.Fatalf("Failed to resolve policy: %v", err)
+	}
+
+	for _, selector := range [][4]byte{
+		utils.CreatePaymentAccountSelector,
+		utils.CollectFundsSelector,
+		utils.CreateCollectionAccountSelector,
+		utils.GetMethodSelector("emergencyPause(bool)"),
+	} {
+		if resolved[selector] != safeController {
+			t.Errorf("Expected selector %x to resolve to the Safe controller", selector)
+		}
+	}
+
+	if _, err := enterprise.ResolveMethodControllerPolicy(walletABI, enterprise.MethodControllerPolicy{"unknownMethod": safeController}); err == nil {
+		t.Error("Expected error for unknown method")
+	}
+	if _, err := enterprise.ResolveMethodControllerPolicy(walletABI, enterprise.MethodControllerPolicy{
+		"createPaymentAccount":                 safeController,
+		"createPaymentAccount(string,address)": common.HexToAddress("0x7777777777777777777777777777777777777777"),
+	}); err == nil {
+		t.Error("Expected error for conflicting controllers")
+	}
+
+	// A bare name shared by overloads is ambiguous, signatures and generated names are not
+	overloadedABI, err := abi.JSON(strings.NewReader(`[
+		{"type":"function","name":"transfer","inputs":[{"name":"to","type":"address"}],"outputs":[],"stateMutability":"nonpayable"},
+		{"type":"function","name":"transfer","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[],"stateMutability":"nonpayable"}
+	]`))
+	if err != nil {
+		t.Fatalf("Failed to parse ABI: %v", err)
+	}
+	if _, err := enterprise.ResolveMethodControllerPolicy(&overloadedABI, enterprise.MethodControllerPolicy{"transfer": safeController}); err == nil {
+		t.Error("Expected error for an overloaded method name")
+	}
+	for _, key := range []string{"transfer(address,uint256)", "transfer0"} {
+		resolved, err := enterprise.ResolveMethodControllerPolicy(&overloadedABI, enterprise.MethodControllerPolicy{key: safeController})
+		if err != nil {
+			t.Fatalf("Failed to resolve %s: %v", key, err)
+		}
+		if resolved[utils.GetMethodSelector("transfer(address,uint256)")] != safeController {
+			t.Errorf("Expected %s to resolve to transfer(address,uint256)", key)
+		}
+	}
+}
+
+func TestEvaluateSuperAdminTransfer(t *testing.T) {
+	transfer := enterprise.SuperAdminTransfer{
+		ProposedSuperAdmin: common.HexToAddress("0x8888888888888888888888888888888888888888"),
+		ProposedAt:         big.NewInt(1_000_000),
+		Timeout:            big.NewInt(86400),
+		IsActive:           true,
+	}
+	proposedAt := time.Unix(1_000_000, 0)
+
+	tests := []struct {
+		name     string
+		transfer enterprise.SuperAdminTransfer
+		valid    bool
+		now      time.Time
+		expected enterprise.SuperAdminTransferState
+	}{
+		{"Inactive", enterprise.SuperAdminTransfer{}, false, proposedAt, enterprise.SuperAdminTransferNone},
+		{"Pending", transfer, true, proposedAt.Add(time.Hour), enterprise.SuperAdminTransferPending},
+		{"Expiring", transfer, true, proposedAt.Add(23 * time.Hour), enterprise.SuperAdminTransferExpiring},
+		{"ExpiredByTime", transfer, true, proposedAt.Add(25 * time.Hour), enterprise.SuperAdminTransferExpired},
+		{"InvalidOnChain", transfer, false, proposedAt.Add(time.Hour), enterprise.SuperAdminTransferExpired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status := enterprise.EvaluateSuperAdminTransfer(tt.transfer, tt.valid, tt.now, 2*time.Hour)
+			if status.State != tt.expected {
+				t.Errorf("Expected state %s, got %s", tt.expected, status.State)
+			}
+			if status.CanConfirm() != (tt.expected == enterprise.SuperAdminTransferPending || tt.expected == enterprise.SuperAdminTransferExpiring) {
+				t.Errorf("Unexpected CanConfirm result for state %s", status.State)
+			}
+		})
+	}
+}
+
+func TestPlanBatchCollection(t *testing.T) {
+	walletABI, err := contracts.EnterpriseWalletMetaData.GetAbi()
+	if err != nil {
+		t.Fatalf("Failed to get ABI: %v", err)
+	}
+	erc20ABI, err := abi.JSON(strings.NewReader(utils.ERC20ABI))
+	if err != nil {
+		t.Fatalf("Failed to parse ERC20 ABI: %v", err)
+	}
+
+	walletAddress := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	token := common.HexToAddress("0x9999999999999999999999999999999999999999")
+	accountA := common.HexToAddress("0xa000000000000000000000000000000000000001")
+	accountB := common.HexToAddress("0xa000000000000000000000000000000000000002")
+	accountC := common.HexToAddress("0xa000000000000000000000000000000000000003")
+
+	backend := newFakeBackend()
+	accounts := []contracts.IAccountManagementAccountInfo{
+		{Account: accountA, CreatedAt: big.NewInt(1), IsActive: true},
+		{Account: accountB, CreatedAt: big.NewInt(2), IsActive: true},
+		{Account: accountC, CreatedAt: big.NewInt(3), IsActive: false},
+	}
+	backend.handle(walletAddress, walletABI, "getCollectionAccountsPaginated", func(args []interface{}) ([]interface{}, error) {
+		offset, limit := args[0].(*big.Int).Int64(), args[1].(*big.Int).Int64()
+		end := offset + limit
+		if end > int64(len(accounts)) {
+			end = int64(len(accounts))
+		}
+		return []interface{}{accounts[offset:end], big.NewInt(int64(len(accounts)))}, nil
+	})
+	backend.handle(walletAddress, walletABI, "isFrozen", func(args []interface{}) ([]interface{}, error) {
+		return []interface{}{false}, nil
+	})
+	tokenBalances := map[common.Address]int64{accountA: 500, accountB: 5, accountC: 1000}
+	backend.handle(token, &erc20ABI, "balanceOf", func(args []interface{}) ([]interface{}, error) {
+		return []interface{}{big.NewInt(tokenBalances[args[0].(common.Address)])}, nil
+	})
+	backend.balances[accountB] = big.NewInt(1e18)
+
+	client, err := enterprise.NewEnterpriseWalletClient(walletAddress, backend)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	plan, err := client.PlanBatchCollection(context.Background(), enterprise.CollectOptions{
+		Tokens:     []common.Address{token, {}},
+		MinAmounts: map[common.Address]*big.Int{token: big.NewInt(10)},
+		PageSize:   2,
+	})
+	if err != nil {
+		t.Fatalf("Failed to plan collection: %v", err)
+	}
+
+	if len(plan.Batches) != 2 {
+		t.Fatalf("Expected 2 batches, got %d", len(plan.Batches))
+	}
+
+	// accountB holds dust and accountC is inactive
+	expectedToken, _ := utils.BatchCollectFundsData(token, []common.Address{accountA})
+	if !bytes.Equal(plan.Batches[0].Call.Data, expectedToken) || plan.Batches[0].Total.Int64() != 500 {
+		t.Errorf("Unexpected token batch: %+v", plan.Batches[0])
+	}
+	expectedETH, _ := utils.BatchCollectFundsData(common.Address{}, []common.Address{accountB})
+	if !bytes.Equal(plan.Batches[1].Call.Data, expectedETH) {
+		t.Errorf("Unexpected ETH batch: %+v", plan.Batches[1])
+	}
+
+	skipped := 0
+	for _, balance := range plan.Balances {
+		if balance.Skipped {
+			skipped++
+		}
+	}
+	if len(plan.Balances) != 6 || skipped != 4 {
+		t.Errorf("Expected 6 balances with 4 skipped, got %d with %d skipped", len(plan.Balances), skipped)
+	}
+
+	sender := &recordingSender{}
+	results, err := client.ExecuteBatchCollection(context.Background(), sender, plan)
+	if err != nil {
+		t.Fatalf("Failed to execute plan: %v", err)
+	}
+	if len(results) != 2 || len(sender.calls) != 2 {
+		t.Errorf("Expected one call per token, got %d", len(sender.calls))
+	}
+}
+
+func TestLockdownIsIdempotent(t *testing.T) {
+	walletABI, err := contracts.EnterpriseWalletMetaData.GetAbi()
+	if err != nil {
+		t.Fatalf("Failed to get ABI: %v", err)
+	}
+
+	walletAddress := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	payment := common.HexToAddress("0xb000000000000000000000000000000000000001")
+	collection := common.HexToAddress("0xb000000000000000000000000000000000000002")
+
+	frozen := map[common.Address]bool{payment: true}
+	paused := false
+
+	backend := newFakeBackend()
+	page := func(account common.Address) func([]interface{}) ([]interface{}, error) {
+		return func(args []interface{}) ([]interface{}, error) {
+			return []interface{}{[]contracts.IAccountManagementAccountInfo{{Account: account, CreatedAt: big.NewInt(1), IsActive: true}}, big.NewInt(1)}, nil
+		}
+	}
+	backend.handle(walletAddress, walletABI, "getPaymentAccountsPaginated", page(payment))
+	backend.handle(walletAddress, walletABI, "getCollectionAccountsPaginated", page(collection))
+	backend.handle(walletAddress, walletABI, "isFrozen", func(args []interface{}) ([]interface{}, error) {
+		return []interface{}{frozen[args[0].(common.Address)]}, nil
+	})
+	backend.handle(walletAddress, walletABI, "isPaused", func(args []interface{}) ([]interface{}, error) {
+		return []interface{}{paused}, nil
+	})
+
+	client, err := enterprise.NewEnterpriseWalletClient(walletAddress, backend)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	safe, err := protocol.NewSafe(protocol.SafeConfig{
+		SafeAddress: "0x1111111111111111111111111111111111111111",
+		RpcURL:      "http://127.0.0.1:0",
+		ChainID:     1,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create Safe: %v", err)
+	}
+	sender := enterprise.NewSafeSender(safe).WithNonce(7)
+
+	lockdown, err := enterprise.Lockdown(context.Background(), client, sender)
+	if err != nil {
+		t.Fatalf("Failed to plan lockdown: %v", err)
+	}
+	plan := lockdown
+	if len(plan.Targets) != 1 || plan.Targets[0] != collection || !plan.TogglePause {
+		t.Errorf("Expected to freeze only the collection account and pause, got %+v", plan)
+	}
+	expectedFreeze, _ := utils.BatchEmergencyFreezeData([]common.Address{collection}, true)
+	if len(plan.Calls) != 2 || !bytes.Equal(plan.Calls[0].Data, expectedFreeze) {
+		t.Errorf("Expected freeze followed by pause")
+	}
+	if plan.Transaction == nil || plan.Transaction.Data.Operation != types.DelegateCall || plan.Transaction.Data.Nonce != 7 {
+		t.Errorf("Expected a single MultiSend Safe transaction, got %+v", plan.Transaction)
+	}
+
+	// Once locked down there is nothing left to do
+	frozen[collection] = true
+	paused = true
+	plan, err = enterprise.Lockdown(context.Background(), client, sender)
+	if err != nil {
+		t.Fatalf("Failed to plan lockdown: %v", err)
+	}
+	if !plan.IsEmpty() || plan.Transaction != nil {
+		t.Errorf("Expected an empty plan for a locked down wallet")
+	}
+
+	// Unlock only reverses the lockdown: the payment account was frozen before it
+	plan, err = enterprise.Unlock(context.Background(), client, sender, lockdown)
+	if err != nil {
+		t.Fatalf("Failed to plan unlock: %v", err)
+	}
+	expectedPause, _ := utils.EmergencyPauseData(false)
+	expectedUnfreeze, _ := utils.BatchEmergencyFreezeData([]common.Address{collection}, false)
+	if len(plan.Targets) != 1 || plan.Targets[0] != collection || len(plan.Calls) != 2 ||
+		!bytes.Equal(plan.Calls[0].Data, expectedPause) ||
+		!bytes.Equal(plan.Calls[1].Data, expectedUnfreeze) {
+		t.Errorf("Expected unpause followed by unfreezing the collection account, got %+v", plan)
+	}
+	if _, err := enterprise.Unlock(context.Background(), client, sender, plan); err == nil {
+		t.Error("Expected unlock to require a lockdown plan")
+	}
+
+	// A paused wallet with one unfrozen account is unpaused around the freeze
+	frozen[collection] = false
+	plan, err = enterprise.Lockdown(context.Background(), client, sender)
+	if err != nil {
+		t.Fatalf("Failed to plan lockdown: %v", err)
+	}
+	pause, _ := utils.EmergencyPauseData(true)
+	if plan.TogglePause || len(plan.Targets) != 1 || len(plan.Calls) != 3 ||
+		!bytes.Equal(plan.Calls[0].Data, expectedPause) ||
+		!bytes.Equal(plan.Calls[1].Data, expectedFreeze) ||
+		!bytes.Equal(plan.Calls[2].Data, pause) {
+		t.Errorf("Expected unpause, freeze and pause, got %+v", plan)
+	}
+
+	// The wallet was paused before that lockdown, so unlocking keeps it paused
+	frozen[collection] = true
+	plan, err = enterprise.Unlock(context.Background(), client, sender, plan)
+	if err != nil {
+		t.Fatalf("Failed to plan unlock: %v", err)
+	}
+	if plan.TogglePause || len(plan.Targets) != 1 || plan.Targets[0] != collection || len(plan.Calls) != 3 ||
+		!bytes.Equal(plan.Calls[0].Data, expectedPause) ||
+		!bytes.Equal(plan.Calls[1].Data, expectedUnfreeze) ||
+		!bytes.Equal(plan.Calls[2].Data, pause) {
+		t.Errorf("Expected unpause, unfreeze and pause, got %+v", plan)
+	}
+}
+
+func TestPredictEnterpriseAccountAddresses(t *testing.T) {
+	// Addresses returned by predictPaymentAccountAddress/predictCollectionAccountAddress
+	// of the EnterpriseWallet bytecode in abi/EnterpriseWallet_full.json
+	walletAddress := common.HexToAddress("0x5FbDB2315678afecb367f032d93F642f64180aa3")
+	payment := []common.Address{
+		common.HexToAddress("0x408E4038a65BDE4e11b76fCC2Ce4fC994Cb5e1FA"),
+		common.HexToAddress("0xadB0013E8b5de800767Ad818bB9b46c632eb6762"),
+		common.HexToAddress("0x7e14eeb49A6a150022D33eE871Da1586E8798e8C"),
+	}
+	collection := []common.Address{
+		common.HexToAddress("0xC20edA4435bF80164b4E1455bcf571865c793C8e"),
+		common.HexToAddress("0x36b6247311379b676764373c88454686eb4F851F"),
+		common.HexToAddress("0xb63e3ff5631844384cA5AF6fF61A0FA936c23D40"),
+	}
+
+	for i := range payment {
+		nonce := big.NewInt(int64(i))
+		if got := utils.CalculatePaymentAccountAddress(walletAddress, nonce); got != payment[i] {
+			t.Errorf("Payment account %d: expected %s, got %s", i, payment[i].Hex(), got.Hex())
+		}
+		if got := utils.CalculateCollectionAccountAddress(walletAddress, nonce); got != collection[i] {
+			t.Errorf("Collection account %d: expected %s, got %s", i, collection[i].Hex(), got.Hex())
+		}
+	}
+
+	walletABI, err := contracts.EnterpriseWalletMetaData.GetAbi()
+	if err != nil {
+		t.Fatalf("Failed to get ABI: %v", err)
+	}
+	backend := newFakeBackend()
+	backend.handle(walletAddress, walletABI, "getPaymentAccountNonce", func(args []interface{}) ([]interface{}, error) {
+		return []interface{}{big.NewInt(1)}, nil
+	})
+
+	wallet, err := enterprise.NewEnterpriseWalletClient(walletAddress, backend)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	next, err := wallet.NextPaymentAccountAddresses(context.Background(), 2)
+	if err != nil {
+		t.Fatalf("Failed to predict payment accounts: %v", err)
+	}
+	if len(next) != 2 || next[0] != payment[1] || next[1] != payment[2] {
+		t.Errorf("Expected payment accounts %v, got %v", payment[1:], next)
+	}
+}
+
+func TestRescueFunds(t *testing.T) {
+	walletABI, err := contracts.EnterpriseWalletMetaData.GetAbi()
+	if err != nil {
+		t.Fatalf("Failed to get ABI: %v", err)
+	}
+	erc20ABI, err := abi.JSON(strings.NewReader(utils.ERC20ABI))
+	if err != nil {
+		t.Fatalf("Failed to parse ERC20 ABI: %v", err)
+	}
+
+	walletAddress := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	superAdmin := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	recipient := common.HexToAddress("0x3333333333333333333333333333333333333333")
+	token := common.HexToAddress("0x9999999999999999999999999999999999999999")
+	idle := common.HexToAddress("0x8888888888888888888888888888888888888888")
+
+	backend := newFakeBackend()
+	backend.balances[walletAddress] = big.NewInt(5)
+	backend.handle(walletAddress, walletABI, "getSuperAdmin", func(args []interface{}) ([]interface{}, error) {
+		return []interface{}{superAdmin}, nil
+	})
+	backend.handle(token, &erc20ABI, "balanceOf", func(args []interface{}) ([]interface{}, error) {
+		return []interface{}{big.NewInt(7)}, nil
+	})
+	backend.handle(idle, &erc20ABI, "balanceOf", func(args []interface{}) ([]interface{}, error) {
+		return []interface{}{big.NewInt(0)}, nil
+	})
+
+	client, err := enterprise.NewEnterpriseWalletClient(walletAddress, backend)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	plan, err := client.PlanRescue(context.Background(), []common.Address{token, idle}, recipient)
+	if err != nil {
+		t.Fatalf("Failed to plan rescue: %v", err)
+	}
+	if len(plan.Balances) != 2 || plan.Balances[0].Token != (common.Address{}) || plan.Balances[1].Token != token {
+		t.Fatalf("Expected ETH and token balances, got %+v", plan.Balances)
+	}
+
+	// Each rescue pays the super admin and is followed by a transfer to the recipient
+	rescueETH, _ := utils.RescueFundsData(common.Address{}, big.NewInt(5))
+	forwardToken, _ := utils.CreateERC20TransferData(recipient.Hex(), big.NewInt(7))
+	if len(plan.Calls) != 4 || !bytes.Equal(plan.Calls[0].Data, rescueETH) ||
+		plan.Calls[1].To != recipient || plan.Calls[1].Value.Int64() != 5 ||
+		plan.Calls[3].To != token || !bytes.Equal(plan.Calls[3].Data, forwardToken) {
+		t.Errorf("Unexpected rescue calls: %+v", plan.Calls)
+	}
+
+	if _, err := client.ExecuteRescue(context.Background(), &recordingSender{}, plan); err == nil {
+		t.Error("Expected forwarding without a batch sender to be rejected")
+	}
+
+	event := walletABI.Events["FundsRescued"]
+	rescuedLog := func(token common.Address, amount int64) *gethtypes.Log {
+		data, _ := event.Inputs.NonIndexed().Pack(big.NewInt(amount))
+		return &gethtypes.Log{
+			Address: walletAddress,
+			Topics:  []common.Hash{event.ID, common.BytesToHash(superAdmin.Bytes()), common.BytesToHash(token.Bytes())},
+			Data:    data,
+		}
+	}
+	receipt := &gethtypes.Receipt{
+		Status: gethtypes.ReceiptStatusSuccessful,
+		Logs:   []*gethtypes.Log{rescuedLog(common.Address{}, 5), rescuedLog(token, 7)},
+	}
+	events, err := client.VerifyRescue(plan, receipt)
+	if err != nil || len(events) != 2 {
+		t.Errorf("Expected rescue to verify, got %d events: %v", len(events), err)
+	}
+
+	receipt.Logs = receipt.Logs[:1]
+	if _, err := client.VerifyRescue(plan, receipt); err == nil {
+		t.Error("Expected a missing token rescue to fail verification")
+	}
+}
+
+func TestEnterpriseWalletLegacyBindings(t *testing.T) {
+	walletAddress := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	wallet, err := contracts.NewEnterpriseWallet(walletAddress, newFakeBackend())
+	if err != nil {
+		t.Fatalf("Failed to bind wallet: %v", err)
+	}
+
+	// The previous struct names still refer to the regenerated types
+	var config contracts.IEnterpriseWalletMethodConfig = contracts.IEnterpriseWalletTypesMethodConfig{}
+	_ = config
+
+	// Reads and writes of removed methods fail alike
+	if _, err := wallet.GetAllowance(nil, common.Address{}, common.Address{}); !errors.Is(err, contracts.ErrEnterpriseWalletMethodRemoved) {
+		t.Errorf("Expected removed method error, got %v", err)
+	}
+	if _, err := wallet.ApproveTokenForPayment(&bind.TransactOpts{}, common.Address{}, common.Address{}, big.NewInt(1)); !errors.Is(err, contracts.ErrEnterpriseWalletMethodRemoved) {
+		t.Errorf("Expected removed method error, got %v", err)
+	}
+	if _, err := wallet.TransferETHToPayment(&bind.TransactOpts{}, common.Address{}, big.NewInt(1)); !errors.Is(err, contracts.ErrEnterpriseWalletMethodRemoved) {
+		t.Errorf("Expected removed method error, got %v", err)
+	}
+
+	paymentAccount := common.HexToAddress("0x4444444444444444444444444444444444444444")
+	token := common.HexToAddress("0x5555555555555555555555555555555555555555")
+	log := gethtypes.Log{
+		Address: walletAddress,
+		Topics: []common.Hash{
+			common.HexToHash("0x35b43951b46e772259ca8b566c89beccb8d46513d2e0388b81504e7d27784f29"),
+			common.BytesToHash(paymentAccount.Bytes()),
+			common.BytesToHash(token.Bytes()),
+		},
+		Data: common.LeftPadBytes(big.NewInt(42).Bytes(), 32),
+	}
+	event, err := wallet.ParseAllowanceSet(log)
+	if err != nil {
+		t.Fatalf("Failed to parse AllowanceSet: %v", err)
+	}
+	if event.PaymentAccount != paymentAccount || event.Token != token || event.Amount.Int64() != 42 {
+		t.Errorf("Unexpected event %+v", event)
+	}
+}
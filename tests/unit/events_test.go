@@ -0,0 +1,76 @@
+package unit
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/vikkkko/safe-core-sdk-golang/protocol"
+	"github.com/vikkkko/safe-core-sdk-golang/protocol/utils"
+)
+
+func TestDecodeSafeLog(t *testing.T) {
+	topic, err := protocol.SafeEventTopic(protocol.SafeEventExecutionSuccess)
+	if err != nil {
+		t.Fatalf("Failed to get topic: %v", err)
+	}
+
+	safeTxHash := common.HexToHash("0xabcdef0000000000000000000000000000000000000000000000000000000001")
+	payment := common.LeftPadBytes(big.NewInt(42).Bytes(), 32)
+
+	tests := []struct {
+		name string
+		log  gethtypes.Log
+	}{
+		{
+			// Safe 1.4.1 indexes txHash
+			name: "IndexedArguments",
+			log: gethtypes.Log{
+				Topics:      []common.Hash{topic, safeTxHash},
+				Data:        payment,
+				BlockNumber: 100,
+			},
+		},
+		{
+			// Safe 1.3.0 emits every argument in the data section
+			name: "NonIndexedArguments",
+			log: gethtypes.Log{
+				Topics:      []common.Hash{topic},
+				Data:        append(safeTxHash.Bytes(), payment...),
+				BlockNumber: 100,
+				Removed:     true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event, err := protocol.DecodeSafeLog(tt.log)
+			if err != nil {
+				t.Fatalf("Failed to decode log: %v", err)
+			}
+
+			if event.Type != protocol.SafeEventExecutionSuccess {
+				t.Errorf("Expected ExecutionSuccess, got %s", event.Type)
+			}
+			if event.Removed != tt.log.Removed {
+				t.Errorf("Expected Removed to be %t", tt.log.Removed)
+			}
+
+			data, ok := event.Data.(*utils.SafeContractExecutionSuccess)
+			if !ok {
+				t.Fatalf("Unexpected payload type %T", event.Data)
+			}
+			if common.Hash(data.TxHash) != safeTxHash {
+				t.Errorf("Expected txHash %s, got %x", safeTxHash.Hex(), data.TxHash)
+			}
+			if data.Payment.Int64() != 42 {
+				t.Errorf("Expected payment 42, got %s", data.Payment)
+			}
+			if data.Raw.BlockNumber != 100 {
+				t.Errorf("Expected raw log to be attached")
+			}
+		})
+	}
+}
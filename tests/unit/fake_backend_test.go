@@ -0,0 +1,118 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// fakeBackend is a bind.ContractBackend answering eth_call from registered
+// method handlers. Unhandled calls revert.
+type fakeBackend struct {
+	head     *big.Int
+	time     uint64
+	balances map[common.Address]*big.Int
+	code     map[common.Address][]byte
+	handlers map[common.Address]map[[4]byte]func(args []interface{}) ([]interface{}, error)
+	abis     map[common.Address]*abi.ABI
+}
+
+func newFakeBackend() *fakeBackend {
+	return &fakeBackend{
+		head:     big.NewInt(100),
+		balances: make(map[common.Address]*big.Int),
+		code:     make(map[common.Address][]byte),
+		handlers: make(map[common.Address]map[[4]byte]func([]interface{}) ([]interface{}, error)),
+		abis:     make(map[common.Address]*abi.ABI),
+	}
+}
+
+// handle registers a handler for method of the contract at address
+func (b *fakeBackend) handle(address common.Address, contractABI *abi.ABI, method string, handler func(args []interface{}) ([]interface{}, error)) {
+	if b.handlers[address] == nil {
+		b.handlers[address] = make(map[[4]byte]func([]interface{}) ([]interface{}, error))
+	}
+	var selector [4]byte
+	copy(selector[:], contractABI.Methods[method].ID)
+	b.handlers[address][selector] = handler
+	b.abis[address] = contractABI
+	b.code[address] = []byte{0x01}
+}
+
+func (b *fakeBackend) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if call.To == nil || len(call.Data) < 4 {
+		return nil, errors.New("execution reverted")
+	}
+	var selector [4]byte
+	copy(selector[:], call.Data[:4])
+	handler, ok := b.handlers[*call.To][selector]
+	if !ok {
+		return nil, errors.New("execution reverted")
+	}
+
+	method, err := b.abis[*call.To].MethodById(selector[:])
+	if err != nil {
+		return nil, err
+	}
+	args, err := method.Inputs.Unpack(call.Data[4:])
+	if err != nil {
+		return nil, err
+	}
+	out, err := handler(args)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(out...)
+}
+
+func (b *fakeBackend) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return b.code[contract], nil
+}
+
+func (b *fakeBackend) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	if balance, ok := b.balances[account]; ok {
+		return balance, nil
+	}
+	return big.NewInt(0), nil
+}
+
+func (b *fakeBackend) HeaderByNumber(ctx context.Context, number *big.Int) (*gethtypes.Header, error) {
+	return &gethtypes.Header{Number: new(big.Int).Set(b.head), Time: b.time}, nil
+}
+
+func (b *fakeBackend) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
+	return b.code[account], nil
+}
+
+func (b *fakeBackend) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	return 0, nil
+}
+
+func (b *fakeBackend) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return big.NewInt(1), nil
+}
+
+func (b *fakeBackend) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	return big.NewInt(1), nil
+}
+
+func (b *fakeBackend) EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
+	return 21000, nil
+}
+
+func (b *fakeBackend) SendTransaction(ctx context.Context, tx *gethtypes.Transaction) error {
+	return errors.New("not supported")
+}
+
+func (b *fakeBackend) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]gethtypes.Log, error) {
+	return nil, nil
+}
+
+func (b *fakeBackend) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- gethtypes.Log) (ethereum.Subscription, error) {
+	return nil, errors.New("notifications not supported")
+}
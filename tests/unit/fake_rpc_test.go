@@ -0,0 +1,256 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// fakeRPC serves the eth JSON-RPC methods used by the SDK from a fakeBackend, for
+// code taking an *ethclient.Client or an RPC URL. Storage and logs are kept here.
+type fakeRPC struct {
+	*fakeBackend
+	URL string
+
+	mu        sync.Mutex
+	storage   map[common.Address]map[common.Hash]common.Hash
+	logs      []gethtypes.Log
+	fork      byte                              // changes every block hash, simulating a reorg
+	overrides []map[common.Address]fakeOverride // state overrides received by eth_call
+	callErr   error                             // error returned by every eth_call when set
+}
+
+func newFakeRPC(t *testing.T) *fakeRPC {
+	f := &fakeRPC{
+		fakeBackend: newFakeBackend(),
+		storage:     make(map[common.Address]map[common.Hash]common.Hash),
+	}
+
+	server := rpc.NewServer()
+	if err := server.RegisterName("eth", &fakeEthService{f}); err != nil {
+		t.Fatalf("Failed to register fake eth service: %v", err)
+	}
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(func() {
+		httpServer.Close()
+		server.Stop()
+	})
+	f.URL = httpServer.URL
+
+	return f
+}
+
+// client dials the fake RPC
+func (f *fakeRPC) client(t *testing.T) *ethclient.Client {
+	client, err := ethclient.Dial(f.URL)
+	if err != nil {
+		t.Fatalf("Failed to dial fake RPC: %v", err)
+	}
+	t.Cleanup(client.Close)
+	return client
+}
+
+func (f *fakeRPC) setStorage(address common.Address, slot, value common.Hash) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.storage[address] == nil {
+		f.storage[address] = make(map[common.Hash]common.Hash)
+	}
+	f.storage[address][slot] = value
+}
+
+func (f *fakeRPC) setHead(head uint64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.head = new(big.Int).SetUint64(head)
+}
+
+// blockHash returns the hash of block number on the current fork
+func (f *fakeRPC) blockHash(number uint64) common.Hash {
+	return f.header(number).Hash()
+}
+
+func (f *fakeRPC) header(number uint64) *gethtypes.Header {
+	return &gethtypes.Header{
+		Number:     new(big.Int).SetUint64(number),
+		Time:       f.time + number,
+		Difficulty: big.NewInt(0),
+		Extra:      []byte{f.fork},
+	}
+}
+
+// addLog appends a log to the canonical chain, filling in the block hash
+func (f *fakeRPC) addLog(log gethtypes.Log) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	log.BlockHash = f.blockHash(log.BlockNumber)
+	f.logs = append(f.logs, log)
+}
+
+// reorg drops the logs from block number on and changes every block hash
+func (f *fakeRPC) reorg(number uint64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.fork++
+	kept := f.logs[:0]
+	for _, log := range f.logs {
+		if log.BlockNumber < number {
+			log.BlockHash = f.blockHash(log.BlockNumber)
+			kept = append(kept, log)
+		}
+	}
+	f.logs = kept
+}
+
+func (f *fakeRPC) blockNumber(arg string) uint64 {
+	switch arg {
+	case "", "latest", "pending", "safe", "finalized":
+		return f.head.Uint64()
+	case "earliest":
+		return 0
+	}
+	number, err := hexutil.DecodeUint64(arg)
+	if err != nil {
+		return f.head.Uint64()
+	}
+	return number
+}
+
+// fakeEthService implements the eth namespace of fakeRPC
+type fakeEthService struct {
+	f *fakeRPC
+}
+
+type fakeCallArgs struct {
+	From  *common.Address `json:"from"`
+	To    *common.Address `json:"to"`
+	Data  hexutil.Bytes   `json:"data"`
+	Input hexutil.Bytes   `json:"input"`
+	Value *hexutil.Big    `json:"value"`
+}
+
+type fakeOverride struct {
+	StateDiff map[common.Hash]common.Hash `json:"stateDiff"`
+}
+
+type fakeFilterArgs struct {
+	FromBlock string           `json:"fromBlock"`
+	ToBlock   string           `json:"toBlock"`
+	Address   []common.Address `json:"address"`
+	Topics    [][]common.Hash  `json:"topics"`
+}
+
+func (s *fakeEthService) ChainId() *hexutil.Big {
+	return (*hexutil.Big)(big.NewInt(1))
+}
+
+func (s *fakeEthService) BlockNumber() hexutil.Uint64 {
+	s.f.mu.Lock()
+	defer s.f.mu.Unlock()
+	return hexutil.Uint64(s.f.head.Uint64())
+}
+
+func (s *fakeEthService) GetBlockByNumber(number string, full bool) (*gethtypes.Header, error) {
+	s.f.mu.Lock()
+	defer s.f.mu.Unlock()
+	n := s.f.blockNumber(number)
+	if n > s.f.head.Uint64() {
+		return nil, nil
+	}
+	return s.f.header(n), nil
+}
+
+func (s *fakeEthService) GetCode(address common.Address, block string) hexutil.Bytes {
+	code, _ := s.f.CodeAt(context.Background(), address, nil)
+	return code
+}
+
+func (s *fakeEthService) GetBalance(address common.Address, block string) *hexutil.Big {
+	balance, _ := s.f.BalanceAt(context.Background(), address, nil)
+	return (*hexutil.Big)(balance)
+}
+
+func (s *fakeEthService) GetStorageAt(address common.Address, slot common.Hash, block string) hexutil.Bytes {
+	s.f.mu.Lock()
+	defer s.f.mu.Unlock()
+	value := s.f.storage[address][slot]
+	return value.Bytes()
+}
+
+func (s *fakeEthService) Call(ctx context.Context, args fakeCallArgs, block string, overrides *map[common.Address]fakeOverride) (hexutil.Bytes, error) {
+	s.f.mu.Lock()
+	if overrides != nil {
+		s.f.overrides = append(s.f.overrides, *overrides)
+	}
+	callErr := s.f.callErr
+	s.f.mu.Unlock()
+	if callErr != nil {
+		return nil, callErr
+	}
+
+	data := args.Data
+	if len(data) == 0 {
+		data = args.Input
+	}
+	msg := ethereum.CallMsg{To: args.To, Data: data}
+	if args.From != nil {
+		msg.From = *args.From
+	}
+	return s.f.CallContract(ctx, msg, nil)
+}
+
+func (s *fakeEthService) GetLogs(args fakeFilterArgs) ([]gethtypes.Log, error) {
+	s.f.mu.Lock()
+	defer s.f.mu.Unlock()
+	from, to := s.f.blockNumber(args.FromBlock), s.f.blockNumber(args.ToBlock)
+	if from > to {
+		return nil, errors.New("invalid block range")
+	}
+
+	logs := []gethtypes.Log{}
+	for _, log := range s.f.logs {
+		if log.BlockNumber < from || log.BlockNumber > to || !fakeLogMatches(log, args) {
+			continue
+		}
+		logs = append(logs, log)
+	}
+	return logs, nil
+}
+
+func fakeLogMatches(log gethtypes.Log, args fakeFilterArgs) bool {
+	if len(args.Address) > 0 {
+		found := false
+		for _, address := range args.Address {
+			found = found || address == log.Address
+		}
+		if !found {
+			return false
+		}
+	}
+	for i, topics := range args.Topics {
+		if len(topics) == 0 {
+			continue
+		}
+		if i >= len(log.Topics) {
+			return false
+		}
+		found := false
+		for _, topic := range topics {
+			found = found || topic == log.Topics[i]
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
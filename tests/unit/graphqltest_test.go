@@ -0,0 +1,95 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/vikkkko/safe-core-sdk-golang/graphql"
+	"github.com/vikkkko/safe-core-sdk-golang/graphql/graphqltest"
+)
+
+const subgraphFixtures = `{
+	"_meta": {"block": {"number": 42, "hash": "0xabc"}, "deployment": "Qm1", "hasIndexingErrors": false},
+	"paymentAllowances": [
+		{"id": "a1", "paymentAccount": "0x00000000000000000000000000000000000000aa", "token": "0x01", "owner": "0x02", "amount": "100"},
+		{"id": "a2", "paymentAccount": "0x00000000000000000000000000000000000000bb", "token": "0x01", "owner": "0x03", "amount": "200"}
+	],
+	"paymentApprovals": [
+		{"id": "p1", "account": "0x00000000000000000000000000000000000000aa", "amount": "1", "timestamp": "10"},
+		{"id": "p2", "account": "0x00000000000000000000000000000000000000aa", "amount": "2", "timestamp": "30"},
+		{"id": "p3", "account": "0x00000000000000000000000000000000000000aa", "amount": "3", "timestamp": "20"},
+		{"id": "p4", "account": "0x00000000000000000000000000000000000000aa", "amount": "4", "timestamp": "40"},
+		{"id": "p5", "account": "0x00000000000000000000000000000000000000aa", "amount": "5", "timestamp": "50"},
+		{"id": "p6", "account": "0x00000000000000000000000000000000000000bb", "amount": "6", "timestamp": "60"}
+	]
+}`
+
+func TestFakeSubgraphServer(t *testing.T) {
+	fixtures, err := graphqltest.ParseFixtures(strings.NewReader(subgraphFixtures))
+	if err != nil {
+		t.Fatalf("Failed to parse fixtures: %v", err)
+	}
+	server := graphqltest.NewServer(fixtures)
+	defer server.Close()
+
+	client := server.Client()
+	ctx := context.Background()
+	account := "0x00000000000000000000000000000000000000AA"
+
+	head, err := client.Head(ctx)
+	if err != nil || head.Number != 42 {
+		t.Fatalf("Unexpected head %+v: %v", head, err)
+	}
+
+	allowances, err := client.GetPaymentAllowances(ctx, account)
+	if err != nil {
+		t.Fatalf("Failed to get allowances: %v", err)
+	}
+	if len(allowances) != 1 || allowances[0].Owner != "0x02" || allowances[0].Amount.Int64() != 100 {
+		t.Errorf("Unexpected allowances: %+v", allowances)
+	}
+
+	// Five approvals in pages of two take three requests
+	before := len(server.Requests())
+	approvals, err := client.GetAllPaymentApprovals(ctx, account, graphql.PaginateOptions{PageSize: 2})
+	if err != nil {
+		t.Fatalf("Failed to get approvals: %v", err)
+	}
+	if len(approvals) != 5 || len(server.Requests())-before != 3 {
+		t.Errorf("Expected 5 approvals in 3 requests, got %d in %d", len(approvals), len(server.Requests())-before)
+	}
+
+	// Newest first
+	approvals, err = client.GetPaymentApprovals(ctx, account)
+	if err != nil {
+		t.Fatalf("Failed to get approvals: %v", err)
+	}
+	if approvals[0].ID != "p5" || approvals[4].ID != "p1" {
+		t.Errorf("Expected approvals ordered by timestamp, got %s..%s", approvals[0].ID, approvals[4].ID)
+	}
+
+	// A transient failure is retried
+	server.Fail(graphqltest.Fault{Match: "paymentAllowances", Status: 503})
+	if _, err := client.GetPaymentAllowances(ctx, account); err != nil {
+		t.Errorf("Expected the 503 to be retried: %v", err)
+	}
+
+	// GraphQL errors surface with their details
+	server.Fail(graphqltest.Fault{Errors: []graphql.ErrorDetail{{Message: "store error", Path: []interface{}{"paymentAllowances"}}}})
+	_, err = client.GetPaymentAllowances(ctx, account)
+	var graphqlErr *graphql.GraphQLError
+	if !errors.As(err, &graphqlErr) || graphqlErr.Errors[0].Message != "store error" {
+		t.Errorf("Expected the injected GraphQL error, got %v", err)
+	}
+
+	// Records added later are served
+	server.Add("paymentAllowances", map[string]interface{}{
+		"id": "a3", "paymentAccount": "0x00000000000000000000000000000000000000aa", "token": "0x04", "owner": "0x05", "amount": "300",
+	})
+	allowances, err = client.GetPaymentAllowances(ctx, account)
+	if err != nil || len(allowances) != 2 {
+		t.Errorf("Expected 2 allowances after adding one, got %+v: %v", allowances, err)
+	}
+}
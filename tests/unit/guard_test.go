@@ -0,0 +1,141 @@
+package unit
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/vikkkko/safe-core-sdk-golang/protocol/managers"
+	"github.com/vikkkko/safe-core-sdk-golang/protocol/utils"
+)
+
+var testGuard = common.HexToAddress("0x8888888888888888888888888888888888888888")
+
+// handleSupportsInterface serves supportsInterface on the guard from a set of interface IDs
+func handleSupportsInterface(t *testing.T, f *fakeRPC, supported ...[4]byte) {
+	erc165ABI, err := abi.JSON(strings.NewReader(`[{"inputs":[{"name":"interfaceId","type":"bytes4"}],"name":"supportsInterface","outputs":[{"name":"","type":"bool"}],"stateMutability":"view","type":"function"}]`))
+	if err != nil {
+		t.Fatalf("Failed to parse ERC-165 ABI: %v", err)
+	}
+	f.handle(testGuard, &erc165ABI, "supportsInterface", func(args []interface{}) ([]interface{}, error) {
+		id := args[0].([4]byte)
+		for _, s := range supported {
+			if id == s {
+				return []interface{}{true}, nil
+			}
+		}
+		return []interface{}{false}, nil
+	})
+}
+
+func TestCheckGuardInterfaceDetection(t *testing.T) {
+	erc165 := [4]byte{0x01, 0xff, 0xc9, 0xa7}
+	invalid := [4]byte{0xff, 0xff, 0xff, 0xff}
+
+	tests := []struct {
+		name      string
+		setup     func(f *fakeRPC)
+		wantError string
+	}{
+		// Without an RPC client the simulation cannot run, so the guard is refused
+		{"guard without RPC client", func(f *fakeRPC) { handleSupportsInterface(t, f, erc165, managers.GuardInterfaceID) }, "requires an RPC client"},
+		{"not a contract", func(f *fakeRPC) {}, "is not a contract"},
+		{"no ERC-165", func(f *fakeRPC) { f.code[testGuard] = []byte{0x01} }, "does not support"},
+		{"ERC-165 without Guard", func(f *fakeRPC) { handleSupportsInterface(t, f, erc165) }, "does not support"},
+		{"claims 0xffffffff", func(f *fakeRPC) { handleSupportsInterface(t, f, erc165, invalid, managers.GuardInterfaceID) }, "does not support"},
+		{"transport error", func(f *fakeRPC) {
+			handleSupportsInterface(t, f, erc165, managers.GuardInterfaceID)
+			f.callErr = errors.New("upstream unavailable")
+		}, "upstream unavailable"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFakeRPC(t)
+			tt.setup(f)
+
+			manager := managers.NewGuardManager(f.client(t), common.HexToAddress(testSafe))
+			err := manager.CheckGuard(context.Background(), testGuard)
+			if err == nil || !strings.Contains(err.Error(), tt.wantError) {
+				t.Errorf("Expected error containing %q, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestSetGuardWithoutRPCClientRequiresUnsafe(t *testing.T) {
+	f := newFakeRPC(t)
+	handleSupportsInterface(t, f, [4]byte{0x01, 0xff, 0xc9, 0xa7}, managers.GuardInterfaceID)
+	manager := managers.NewGuardManager(f.client(t), common.HexToAddress(testSafe))
+	ctx := context.Background()
+
+	if _, err := manager.CreateSetGuardTx(ctx, managers.SetGuardTxParams{GuardAddress: testGuard.Hex()}); err == nil {
+		t.Error("Expected setGuard to be refused without a guard simulation")
+	}
+
+	data, err := manager.CreateSetGuardTx(ctx, managers.SetGuardTxParams{GuardAddress: testGuard.Hex(), Unsafe: true})
+	if err != nil {
+		t.Fatalf("Expected the unsafe override to skip the checks, got %v", err)
+	}
+	if len(data) != 36 || !bytes.Equal(data[16:], testGuard.Bytes()) {
+		t.Errorf("Unexpected setGuard calldata %x", data)
+	}
+
+	// Disabling the guard needs no checks
+	if _, err := manager.CreateDisableGuardTx(ctx); err != nil {
+		t.Errorf("Expected disabling the guard to succeed, got %v", err)
+	}
+}
+
+func TestSimulateGuardOverridesThreshold(t *testing.T) {
+	safeABI, err := utils.SafeContractMetaData.GetAbi()
+	if err != nil {
+		t.Fatalf("Failed to get Safe ABI: %v", err)
+	}
+	safeAddress := common.HexToAddress(testSafe)
+	owner := common.HexToAddress("0x1111111111111111111111111111111111111111")
+
+	f := newFakeRPC(t)
+	handleSupportsInterface(t, f, [4]byte{0x01, 0xff, 0xc9, 0xa7}, managers.GuardInterfaceID)
+	f.handle(safeAddress, safeABI, "getOwners", func([]interface{}) ([]interface{}, error) {
+		return []interface{}{[]common.Address{owner}}, nil
+	})
+	succeeds := true
+	f.handle(safeAddress, safeABI, "execTransaction", func([]interface{}) ([]interface{}, error) {
+		return []interface{}{succeeds}, nil
+	})
+
+	rpcClient, err := rpc.Dial(f.URL)
+	if err != nil {
+		t.Fatalf("Failed to dial fake RPC: %v", err)
+	}
+	defer rpcClient.Close()
+	manager := managers.NewGuardManagerWithRPC(rpcClient, safeAddress)
+
+	if err := manager.CheckGuard(context.Background(), testGuard); err != nil {
+		t.Fatalf("Expected guard to pass, got %v", err)
+	}
+
+	if len(f.overrides) != 1 {
+		t.Fatalf("Expected one simulation with state overrides, got %d", len(f.overrides))
+	}
+	diff := f.overrides[0][safeAddress].StateDiff
+	if diff[managers.GuardStorageSlot] != common.BytesToHash(testGuard.Bytes()) {
+		t.Errorf("Expected the guard slot to hold the guard, got %s", diff[managers.GuardStorageSlot].Hex())
+	}
+	thresholdSlot := common.BigToHash(big.NewInt(4))
+	if diff[thresholdSlot] != common.BigToHash(common.Big1) {
+		t.Errorf("Expected the threshold slot to be overridden to 1, got %s", diff[thresholdSlot].Hex())
+	}
+
+	succeeds = false
+	if err := manager.CheckGuard(context.Background(), testGuard); err == nil {
+		t.Error("Expected a failing simulation to reject the guard")
+	}
+}
@@ -0,0 +1,204 @@
+package unit
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/vikkkko/safe-core-sdk-golang/api"
+	"github.com/vikkkko/safe-core-sdk-golang/protocol"
+	"github.com/vikkkko/safe-core-sdk-golang/protocol/utils"
+)
+
+// newMessageService starts a fake Transaction Service storing Safe messages in memory.
+// Signers are recovered from the submitted signatures.
+func newMessageService(threshold int) *httptest.Server {
+	var mu sync.Mutex
+	messages := make(map[string]*api.SafeMessage)
+
+	confirm := func(message *api.SafeMessage, signature string) bool {
+		signer, err := utils.RecoverSigner(common.FromHex(message.MessageHash), common.FromHex(signature))
+		if err != nil {
+			return false
+		}
+		message.Confirmations = append(message.Confirmations, api.SafeMessageConfirmation{
+			Owner:         signer.Hex(),
+			Signature:     signature,
+			SignatureType: "EOA",
+		})
+		return true
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/safes/{address}/", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(api.SafeInfoResponse{Address: r.PathValue("address"), Nonce: "0", Threshold: threshold})
+	})
+	mux.HandleFunc("POST /api/v1/safes/{address}/messages/", func(w http.ResponseWriter, r *http.Request) {
+		var options api.AddMessageOptions
+		if err := json.NewDecoder(r.Body).Decode(&options); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		text, _ := options.Message.(string)
+		hash, err := utils.CalculateMessageHash(common.HexToAddress(r.PathValue("address")), accounts.TextHash([]byte(text)), big.NewInt(1))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		message := &api.SafeMessage{Safe: r.PathValue("address"), MessageHash: "0x" + hex.EncodeToString(hash), Message: text}
+		if !confirm(message, options.Signature) {
+			http.Error(w, "invalid signature", http.StatusBadRequest)
+			return
+		}
+		messages[message.MessageHash] = message
+		w.WriteHeader(http.StatusCreated)
+	})
+	mux.HandleFunc("GET /api/v1/messages/{hash}/", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		message, ok := messages[r.PathValue("hash")]
+		if !ok {
+			http.Error(w, `{"detail":"Not found."}`, http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(message)
+	})
+	mux.HandleFunc("POST /api/v1/messages/{hash}/signatures/", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Signature string `json:"signature"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+
+		mu.Lock()
+		defer mu.Unlock()
+		message, ok := messages[r.PathValue("hash")]
+		if !ok || !confirm(message, body.Signature) {
+			http.Error(w, "invalid signature", http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+	mux.HandleFunc("GET /api/v1/safes/{address}/messages/", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		response := api.SafeMessageListResponse{Results: []api.SafeMessage{}}
+		for _, message := range messages {
+			response.Results = append(response.Results, *message)
+		}
+		response.Count = len(response.Results)
+		json.NewEncoder(w).Encode(response)
+	})
+
+	return httptest.NewServer(mux)
+}
+
+func TestConfirmMessage(t *testing.T) {
+	server := newMessageService(2)
+	defer server.Close()
+
+	kit, err := api.NewSafeApiKit(api.SafeApiKitConfig{ChainID: 1, TxServiceURL: server.URL})
+	if err != nil {
+		t.Fatalf("Failed to create API kit: %v", err)
+	}
+	ctx := context.Background()
+
+	keys := make([]string, 2)
+	owners := make([]common.Address, 2)
+	for i := range keys {
+		key, err := crypto.GenerateKey()
+		if err != nil {
+			t.Fatalf("Failed to generate key: %v", err)
+		}
+		keys[i] = hex.EncodeToString(crypto.FromECDSA(key))
+		owners[i] = crypto.PubkeyToAddress(key.PublicKey)
+	}
+
+	confirm := func(key string) *protocol.ConfirmMessageResult {
+		safe, err := protocol.NewSafe(protocol.SafeConfig{
+			SafeAddress: testSafe,
+			RpcURL:      "http://127.0.0.1:0",
+			ChainID:     1,
+			PrivateKey:  key,
+		})
+		if err != nil {
+			t.Fatalf("Failed to create Safe: %v", err)
+		}
+		result, err := safe.ConfirmMessage(ctx, protocol.ConfirmMessageConfig{Message: "Hello Safe", APIClient: kit})
+		if err != nil {
+			t.Fatalf("Failed to confirm message: %v", err)
+		}
+		return result
+	}
+
+	// The first owner proposes the message
+	result := confirm(keys[0])
+	if !result.SignatureSubmitted || result.ThresholdMet || result.CurrentSignatures != 1 || result.PreparedSignature != "" {
+		t.Errorf("Unexpected result after the first signature: %+v", result)
+	}
+
+	// Signing again is a no-op
+	result = confirm(keys[0])
+	if !result.AlreadySigned || result.SignatureSubmitted {
+		t.Errorf("Expected the first owner to have signed already: %+v", result)
+	}
+
+	// The second owner completes the threshold
+	result = confirm(keys[1])
+	if !result.SignatureSubmitted || !result.ThresholdMet || result.CurrentSignatures != 2 {
+		t.Fatalf("Unexpected result after the second signature: %+v", result)
+	}
+
+	// The prepared signature holds both signatures ordered by owner
+	signatures, err := utils.DecodeSafeSignatures(common.FromHex(result.MessageHash), common.FromHex(result.PreparedSignature), 0)
+	if err != nil {
+		t.Fatalf("Failed to decode prepared signature: %v", err)
+	}
+	if len(signatures) != 2 || strings.ToLower(signatures[0].Signer.Hex()) > strings.ToLower(signatures[1].Signer.Hex()) {
+		t.Fatalf("Expected two signatures ordered by owner, got %+v", signatures)
+	}
+	for _, signature := range signatures {
+		if signature.Signer != owners[0] && signature.Signer != owners[1] {
+			t.Errorf("Unexpected signer %s", signature.Signer.Hex())
+		}
+	}
+
+	messages, err := kit.GetMessages(ctx, testSafe, nil)
+	if err != nil || messages.Count != 1 || len(messages.Results[0].Confirmations) != 2 {
+		t.Errorf("Unexpected messages %+v: %v", messages, err)
+	}
+}
+
+func TestBuildMessageSignatureRejectsContractSignatures(t *testing.T) {
+	confirmations := []api.SafeMessageConfirmation{
+		{Owner: "0x2222222222222222222222222222222222222222", Signature: "0x" + strings.Repeat("22", 65), SignatureType: utils.SignatureTypeEOA},
+		{Owner: "0x1111111111111111111111111111111111111111", Signature: "0x" + strings.Repeat("11", 65), SignatureType: utils.SignatureTypeEOA},
+	}
+	signature, err := protocol.BuildMessageSignature(confirmations)
+	if err != nil {
+		t.Fatalf("Failed to build signature: %v", err)
+	}
+	if signature != "0x"+strings.Repeat("11", 65)+strings.Repeat("22", 65) {
+		t.Errorf("Expected signatures ordered by owner, got %s", signature)
+	}
+
+	confirmations = append(confirmations, api.SafeMessageConfirmation{
+		Owner:         "0x3333333333333333333333333333333333333333",
+		Signature:     "0x" + strings.Repeat("33", 65),
+		SignatureType: utils.SignatureTypeContract,
+	})
+	if _, err := protocol.BuildMessageSignature(confirmations); err == nil {
+		t.Error("Expected contract signatures to be rejected")
+	}
+}
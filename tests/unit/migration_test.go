@@ -0,0 +1,173 @@
+package unit
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/vikkkko/safe-core-sdk-golang/protocol"
+	"github.com/vikkkko/safe-core-sdk-golang/protocol/contracts"
+	"github.com/vikkkko/safe-core-sdk-golang/protocol/managers"
+	"github.com/vikkkko/safe-core-sdk-golang/types"
+)
+
+var fallbackHandlerSlot = common.HexToHash("0x6c9a6c4a39284e37ed1cf53d337577d14212a4870fb976a4366c693b939918d5")
+
+func TestLookupSafeSingleton(t *testing.T) {
+	version, isL2, ok := managers.LookupSafeSingleton(common.HexToAddress("0x3E5c63644E683549055b9Be8653de26E0B4CD36E"))
+	if !ok || version != types.SafeVersion130 || !isL2 {
+		t.Errorf("Expected 1.3.0 L2 singleton, got version=%s l2=%t ok=%t", version, isL2, ok)
+	}
+
+	if _, _, ok := managers.LookupSafeSingleton(common.HexToAddress("0x1234567890123456789012345678901234567890")); ok {
+		t.Error("Expected unknown singleton not to be found")
+	}
+}
+
+// newMigrationRPC serves a Safe on the given singleton with the official 1.3.0
+// fallback handler, and the 1.4.1 SafeMigration contract
+func newMigrationRPC(t *testing.T, singleton common.Address) (*fakeRPC, *string) {
+	safeABI, err := contracts.SafeBindingMetaData.GetAbi()
+	if err != nil {
+		t.Fatalf("Failed to get Safe ABI: %v", err)
+	}
+	migrationABI, err := contracts.SafeMigrationMetaData.GetAbi()
+	if err != nil {
+		t.Fatalf("Failed to get SafeMigration ABI: %v", err)
+	}
+
+	f := newFakeRPC(t)
+	safeAddress := common.HexToAddress(testSafe)
+	target := managers.SafeSingletonDeployments[types.SafeVersion141]
+
+	version := string(types.SafeVersion130)
+	f.setStorage(safeAddress, common.Hash{}, common.BytesToHash(singleton.Bytes()))
+	f.setStorage(safeAddress, fallbackHandlerSlot, common.HexToHash("0xf48f2B2d2a534e402487b3ee7C18c33Aec0Fe5e4"))
+	f.handle(safeAddress, safeABI, "VERSION", func([]interface{}) ([]interface{}, error) {
+		return []interface{}{version}, nil
+	})
+	f.handle(safeAddress, safeABI, "nonce", func([]interface{}) ([]interface{}, error) {
+		return []interface{}{common.Big0}, nil
+	})
+
+	f.handle(target.Migration, migrationABI, "SAFE_SINGLETON", func([]interface{}) ([]interface{}, error) {
+		return []interface{}{target.Singletons[0]}, nil
+	})
+	f.handle(target.Migration, migrationABI, "SAFE_L2_SINGLETON", func([]interface{}) ([]interface{}, error) {
+		return []interface{}{target.L2Singletons[0]}, nil
+	})
+	f.handle(target.Migration, migrationABI, "SAFE_FALLBACK_HANDLER", func([]interface{}) ([]interface{}, error) {
+		return []interface{}{target.FallbackHandlers[0]}, nil
+	})
+	f.code[target.Singletons[0]] = []byte{0x01}
+	f.code[target.L2Singletons[0]] = []byte{0x01}
+
+	return f, &version
+}
+
+func TestCreateMigrationTransaction(t *testing.T) {
+	migrationABI, err := contracts.SafeMigrationMetaData.GetAbi()
+	if err != nil {
+		t.Fatalf("Failed to get SafeMigration ABI: %v", err)
+	}
+	target := managers.SafeSingletonDeployments[types.SafeVersion141]
+	noHandlerUpdate := false
+
+	tests := []struct {
+		name            string
+		singleton       common.Address
+		options         *managers.MigrationOptions
+		method          string
+		targetSingleton common.Address
+	}{
+		{
+			name:            "L1 with official handler",
+			singleton:       common.HexToAddress("0xd9Db270c1B5E3Bd161E8c8503c55cEABeE709552"),
+			method:          "migrateWithFallbackHandler",
+			targetSingleton: target.Singletons[0],
+		},
+		{
+			name:            "L1 keeping handler",
+			singleton:       common.HexToAddress("0xd9Db270c1B5E3Bd161E8c8503c55cEABeE709552"),
+			options:         &managers.MigrationOptions{UpdateFallbackHandler: &noHandlerUpdate},
+			method:          "migrateSingleton",
+			targetSingleton: target.Singletons[0],
+		},
+		{
+			name:            "L2 with official handler",
+			singleton:       common.HexToAddress("0x3E5c63644E683549055b9Be8653de26E0B4CD36E"),
+			method:          "migrateL2WithFallbackHandler",
+			targetSingleton: target.L2Singletons[0],
+		},
+		{
+			name:            "L2 keeping handler",
+			singleton:       common.HexToAddress("0xfb1bffC9d739B8D520DaF37dF666da4C687191EA"),
+			options:         &managers.MigrationOptions{UpdateFallbackHandler: &noHandlerUpdate},
+			method:          "migrateL2Singleton",
+			targetSingleton: target.L2Singletons[0],
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, _ := newMigrationRPC(t, tt.singleton)
+			safe, err := protocol.NewSafe(protocol.SafeConfig{SafeAddress: testSafe, RpcURL: f.URL, ChainID: 1})
+			if err != nil {
+				t.Fatalf("Failed to create Safe: %v", err)
+			}
+
+			tx, plan, err := safe.CreateMigrationTransaction(context.Background(), types.SafeVersion141, tt.options)
+			if err != nil {
+				t.Fatalf("Failed to create migration transaction: %v", err)
+			}
+
+			if plan.Method != tt.method || plan.TargetSingleton != tt.targetSingleton {
+				t.Errorf("Expected %s to %s, got %s to %s", tt.method, tt.targetSingleton.Hex(), plan.Method, plan.TargetSingleton.Hex())
+			}
+			if common.HexToAddress(tx.Data.To) != target.Migration {
+				t.Errorf("Expected the SafeMigration contract as target, got %s", tx.Data.To)
+			}
+			if tx.Data.Operation != types.DelegateCall {
+				t.Errorf("Expected a delegatecall, got %v", tx.Data.Operation)
+			}
+			if !bytes.Equal(hexutil.MustDecode(tx.Data.Data), migrationABI.Methods[tt.method].ID) {
+				t.Errorf("Expected %s selector, got %s", tt.method, tx.Data.Data)
+			}
+		})
+	}
+}
+
+func TestVerifyMigration(t *testing.T) {
+	f, version := newMigrationRPC(t, common.HexToAddress("0xd9Db270c1B5E3Bd161E8c8503c55cEABeE709552"))
+	manager := managers.NewMigrationManager(f.client(t), common.HexToAddress(testSafe))
+	ctx := context.Background()
+
+	plan, err := manager.PrepareMigration(ctx, types.SafeVersion141, nil)
+	if err != nil {
+		t.Fatalf("Failed to prepare migration: %v", err)
+	}
+
+	singleton, err := manager.GetSingleton(ctx)
+	if err != nil || singleton != plan.CurrentSingleton {
+		t.Errorf("Expected singleton %s from slot 0, got %s: %v", plan.CurrentSingleton.Hex(), singleton.Hex(), err)
+	}
+
+	// Not executed yet
+	if err := manager.VerifyMigration(ctx, plan); err == nil {
+		t.Error("Expected verification to fail before the migration")
+	}
+
+	// Singleton swapped, but the handler was not
+	f.setStorage(common.HexToAddress(testSafe), common.Hash{}, common.BytesToHash(plan.TargetSingleton.Bytes()))
+	*version = string(types.SafeVersion141)
+	if err := manager.VerifyMigration(ctx, plan); err == nil {
+		t.Error("Expected verification to fail with the old fallback handler")
+	}
+
+	f.setStorage(common.HexToAddress(testSafe), fallbackHandlerSlot, common.BytesToHash(plan.FallbackHandler.Bytes()))
+	if err := manager.VerifyMigration(ctx, plan); err != nil {
+		t.Errorf("Expected verification to pass, got %v", err)
+	}
+}
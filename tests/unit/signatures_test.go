@@ -0,0 +1,105 @@
+package unit
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/vikkkko/safe-core-sdk-golang/protocol/utils"
+)
+
+func TestDecodeSafeSignatures(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	signer := crypto.PubkeyToAddress(key.PublicKey)
+	dataHash := crypto.Keccak256([]byte("safe tx"))
+
+	eoaSig, err := crypto.Sign(dataHash, key)
+	if err != nil {
+		t.Fatalf("Failed to sign: %v", err)
+	}
+	eoaSig[64] += 27
+
+	ethSignSig, err := crypto.Sign(crypto.Keccak256([]byte("\x19Ethereum Signed Message:\n32"), dataHash), key)
+	if err != nil {
+		t.Fatalf("Failed to sign: %v", err)
+	}
+	ethSignSig[64] += 31
+
+	approver := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	approvedSig := append(common.LeftPadBytes(approver.Bytes(), 32), make([]byte, 32)...)
+	approvedSig = append(approvedSig, 1)
+
+	// Contract signature whose dynamic part starts right after the four static parts
+	contract := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	contractSig := append(common.LeftPadBytes(contract.Bytes(), 32), common.LeftPadBytes(big.NewInt(4*65).Bytes(), 32)...)
+	contractSig = append(contractSig, 0)
+	dynamic := append(common.LeftPadBytes([]byte{3}, 32), 0xaa, 0xbb, 0xcc)
+
+	packed := bytes.Join([][]byte{eoaSig, ethSignSig, approvedSig, contractSig, dynamic}, nil)
+
+	decoded, err := utils.DecodeSafeSignatures(dataHash, packed, 4)
+	if err != nil {
+		t.Fatalf("Failed to decode signatures: %v", err)
+	}
+
+	expected := []struct {
+		signer common.Address
+		kind   string
+	}{
+		{signer, utils.SignatureTypeEOA},
+		{signer, utils.SignatureTypeEthSign},
+		{approver, utils.SignatureTypeApprovedHash},
+		{contract, utils.SignatureTypeContract},
+	}
+
+	if len(decoded) != len(expected) {
+		t.Fatalf("Expected %d signatures, got %d", len(expected), len(decoded))
+	}
+	for i, want := range expected {
+		if decoded[i].Signer != want.signer || decoded[i].Type != want.kind {
+			t.Errorf("Signature %d: got %s/%s, want %s/%s", i, decoded[i].Signer.Hex(), decoded[i].Type, want.signer.Hex(), want.kind)
+		}
+	}
+	if len(decoded[3].Signature) != 65+32+3 {
+		t.Errorf("Expected contract signature to include its dynamic part, got %d bytes", len(decoded[3].Signature))
+	}
+}
+
+func TestDecodeSafeSignaturesOversizedLength(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	dataHash := crypto.Keccak256([]byte("safe tx"))
+	eoaSig, err := crypto.Sign(dataHash, key)
+	if err != nil {
+		t.Fatalf("Failed to sign: %v", err)
+	}
+	eoaSig[64] += 27
+
+	// Contract signature whose length word is close to MaxInt64
+	contract := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	contractSig := append(common.LeftPadBytes(contract.Bytes(), 32), common.LeftPadBytes(big.NewInt(2*65).Bytes(), 32)...)
+	contractSig = append(contractSig, 0)
+	length := common.LeftPadBytes(new(big.Int).SetUint64(1<<63-1).Bytes(), 32)
+
+	packed := bytes.Join([][]byte{eoaSig, contractSig, length}, nil)
+
+	if _, err := utils.DecodeSafeSignatures(dataHash, packed, 2); err == nil {
+		t.Error("Expected an oversized contract signature to be rejected")
+	}
+
+	// Bytes past the threshold are not decoded, as in the Safe
+	decoded, err := utils.DecodeSafeSignatures(dataHash, packed, 1)
+	if err != nil {
+		t.Fatalf("Failed to decode signatures: %v", err)
+	}
+	if len(decoded) != 1 || decoded[0].Signer != crypto.PubkeyToAddress(key.PublicKey) {
+		t.Errorf("Expected only the EOA signature, got %+v", decoded)
+	}
+}
@@ -0,0 +1,101 @@
+package unit
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/vikkkko/safe-core-sdk-golang/protocol"
+)
+
+func TestSubscribeResumesFromConfirmedCheckpoint(t *testing.T) {
+	topic, err := protocol.SafeEventTopic(protocol.SafeEventExecutionSuccess)
+	if err != nil {
+		t.Fatalf("Failed to get topic: %v", err)
+	}
+	executionLog := func(block uint64, safeTxHash common.Hash) gethtypes.Log {
+		return gethtypes.Log{
+			Address:     common.HexToAddress(testSafe),
+			Topics:      []common.Hash{topic, safeTxHash},
+			Data:        common.LeftPadBytes(big.NewInt(0).Bytes(), 32),
+			BlockNumber: block,
+			TxHash:      safeTxHash,
+		}
+	}
+
+	f := newFakeRPC(t)
+	f.setHead(10)
+	f.addLog(executionLog(9, common.HexToHash("0x01")))
+
+	safe, err := protocol.NewSafe(protocol.SafeConfig{SafeAddress: testSafe, RpcURL: f.URL, ChainID: 1})
+	if err != nil {
+		t.Fatalf("Failed to create Safe: %v", err)
+	}
+
+	store := &protocol.MemoryCheckpointStore{}
+	fromBlock := uint64(5)
+	subscribe := func(from *uint64) *protocol.SafeSubscription {
+		sub, err := safe.Subscribe(context.Background(), &protocol.SubscribeOptions{
+			FromBlock:     from,
+			Checkpoint:    store,
+			ForcePolling:  true,
+			PollInterval:  10 * time.Millisecond,
+			Confirmations: 2,
+		})
+		if err != nil {
+			t.Fatalf("Failed to subscribe: %v", err)
+		}
+		return sub
+	}
+	next := func(sub *protocol.SafeSubscription) *protocol.SafeEvent {
+		select {
+		case event := <-sub.Events():
+			return event
+		case <-time.After(5 * time.Second):
+			t.Fatal("Timed out waiting for an event")
+			return nil
+		}
+	}
+	waitCheckpoint := func(want uint64) {
+		deadline := time.Now().Add(5 * time.Second)
+		for {
+			block, ok, _ := store.LoadCheckpoint(context.Background())
+			if ok && block == want {
+				return
+			}
+			if time.Now().After(deadline) {
+				t.Fatalf("Expected checkpoint %d, got %d (saved: %t)", want, block, ok)
+			}
+			time.Sleep(5 * time.Millisecond)
+		}
+	}
+
+	sub := subscribe(&fromBlock)
+	if event := next(sub); event.Raw.BlockNumber != 9 || event.Removed {
+		t.Fatalf("Expected the event of block 9, got %+v", event)
+	}
+	// Block 10 is the head and block 9 is unconfirmed
+	waitCheckpoint(8)
+	sub.Unsubscribe()
+
+	// Both channels are closed once the subscription ends
+	for range sub.Events() {
+	}
+	for range sub.Err() {
+	}
+
+	// While stopped, block 9 is reorged out and the Safe transaction lands in block 10
+	f.reorg(9)
+	f.addLog(executionLog(10, common.HexToHash("0x02")))
+	f.setHead(12)
+
+	sub = subscribe(nil)
+	defer sub.Unsubscribe()
+	if event := next(sub); event.Raw.BlockNumber != 10 || event.Raw.TxHash != common.HexToHash("0x02") {
+		t.Fatalf("Expected the replayed event of block 10, got %+v", event)
+	}
+	waitCheckpoint(10)
+}